@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/netbill/auth-svc/internal/breached"
+	"github.com/netbill/logium"
+	"github.com/pkg/errors"
+)
+
+// BuildBreachedPasswordsFilter builds the bloom filter consulted on password
+// changes from a text dump of breached passwords or of their SHA-1 hashes.
+func BuildBreachedPasswordsFilter(
+	log *logium.Logger,
+	dumpPath, outputPath string,
+	plain bool,
+	falsePositiveRate float64,
+) error {
+	dump, err := os.Open(dumpPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to open dump file")
+	}
+	defer dump.Close()
+
+	var total uint64
+	err = scanDump(dump, plain, func([20]byte) { total++ })
+	if err != nil {
+		return err
+	}
+
+	if _, err = dump.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrap(err, "failed to rewind dump file")
+	}
+
+	filter := breached.NewBloomFilter(total, falsePositiveRate)
+	if err = scanDump(dump, plain, filter.Add); err != nil {
+		return err
+	}
+
+	// The filter is written next to the target first, so a running service
+	// never reads a half written file.
+	tmp, err := os.CreateTemp(filepath.Dir(outputPath), filepath.Base(outputPath)+".*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create filter file")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = filter.WriteTo(tmp); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to write filter file")
+	}
+	if err = tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to write filter file")
+	}
+	if err = os.Rename(tmp.Name(), outputPath); err != nil {
+		return errors.Wrap(err, "failed to move filter file into place")
+	}
+
+	log.WithField("passwords", total).
+		WithField("output", outputPath).
+		Info("breached passwords filter built")
+
+	return nil
+}
+
+func scanDump(dump io.Reader, plain bool, fn func(sum [20]byte)) error {
+	scanner := bufio.NewScanner(dump)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		sum, ok, err := breached.ParseDumpLine(scanner.Text(), plain)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse dump line %d", line)
+		}
+		if ok {
+			fn(sum)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "failed to read dump file")
+	}
+
+	return nil
+}
@@ -48,6 +48,24 @@ func Run(args []string) bool {
 		migrateCmd     = service.Command("migrate", "migrate command")
 		migrateUpCmd   = migrateCmd.Command("up", "migrate db up")
 		migrateDownCmd = migrateCmd.Command("down", "migrate db down")
+
+		migrateUpBackfillSize = migrateUpCmd.Flag("usernames-batch-size", "accounts read per query by the username skeletons backfill").Default("500").Uint()
+
+		reconcileCmd           = service.Command("reconcile", "reconcile replicated data")
+		reconcileOrgMembersCmd = reconcileCmd.Command("org-members", "reconcile organization members replica with a snapshot")
+		orgMembersSnapshot     = reconcileOrgMembersCmd.Arg("snapshot", "path to JSON Lines snapshot file").Required().String()
+		orgMembersTakenAt      = reconcileOrgMembersCmd.Flag("taken-at", "RFC 3339 time the snapshot was taken at").Required().String()
+
+		breachedCmd           = service.Command("breached-passwords", "manage the breached passwords corpus")
+		breachedBuildCmd      = breachedCmd.Command("build", "build a bloom filter from a breached passwords dump")
+		breachedDump          = breachedBuildCmd.Arg("dump", "path to a dump with a SHA-1 hash (optionally HASH:COUNT) per line").Required().String()
+		breachedOutput        = breachedBuildCmd.Arg("output", "path of the filter file to write").Required().String()
+		breachedPlain         = breachedBuildCmd.Flag("plain", "the dump holds plain text passwords instead of hashes").Bool()
+		breachedFalsePositive = breachedBuildCmd.Flag("false-positive-rate", "share of unbreached passwords the filter may reject").Default("0.001").Float64()
+
+		usernamesCmd          = service.Command("usernames", "manage usernames")
+		usernamesBackfillCmd  = usernamesCmd.Command("backfill-skeletons", "store confusable skeletons of existing usernames")
+		usernamesBackfillSize = usernamesBackfillCmd.Flag("batch-size", "accounts read per query").Default("500").Uint()
 	)
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -66,8 +84,19 @@ func Run(args []string) bool {
 		cmd.StartServices(ctx, cfg, log, &wg)
 	case migrateUpCmd.FullCommand():
 		err = migrations.MigrateUp(ctx, cfg.Database.SQL.URL)
+		if err == nil {
+			// new accounts rely on skeletons of the existing ones being stored,
+			// the command fails until confusable usernames are renamed
+			err = cmd.BackfillUsernameSkeletons(ctx, cfg, log, *migrateUpBackfillSize)
+		}
 	case migrateDownCmd.FullCommand():
 		err = migrations.MigrateDown(ctx, cfg.Database.SQL.URL)
+	case reconcileOrgMembersCmd.FullCommand():
+		err = cmd.ReconcileOrgMembers(ctx, cfg, log, *orgMembersSnapshot, *orgMembersTakenAt)
+	case breachedBuildCmd.FullCommand():
+		err = cmd.BuildBreachedPasswordsFilter(log, *breachedDump, *breachedOutput, *breachedPlain, *breachedFalsePositive)
+	case usernamesBackfillCmd.FullCommand():
+		err = cmd.BackfillUsernameSkeletons(ctx, cfg, log, *usernamesBackfillSize)
 	default:
 		log.Errorf("unknown command %s", c)
 		return false
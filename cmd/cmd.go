@@ -5,11 +5,15 @@ import (
 	"sync"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/netbill/auth-svc/internal/breached"
+	"github.com/netbill/auth-svc/internal/confusables"
 	"github.com/netbill/auth-svc/internal/core/modules/account"
 	"github.com/netbill/auth-svc/internal/core/modules/organization"
+	"github.com/netbill/auth-svc/internal/jobs"
 	"github.com/netbill/auth-svc/internal/messenger"
 	"github.com/netbill/auth-svc/internal/messenger/inbound"
 	"github.com/netbill/auth-svc/internal/messenger/outbound"
+	"github.com/netbill/auth-svc/internal/passhash"
 	"github.com/netbill/auth-svc/internal/repository"
 	"github.com/netbill/auth-svc/internal/rest"
 	"github.com/netbill/auth-svc/internal/rest/controller"
@@ -41,15 +45,66 @@ func StartServices(ctx context.Context, cfg Config, log *logium.Logger, wg *sync
 		AccessTTL:  cfg.JWT.User.AccessToken.TokenLifetime,
 		RefreshTTL: cfg.JWT.User.RefreshToken.TokenLifetime,
 		Iss:        cfg.Service.Name,
+
+		PasswordChangeSK:  cfg.JWT.User.PasswordChangeToken.SecretKey,
+		PasswordChangeTTL: cfg.JWT.User.PasswordChangeToken.TokenLifetime,
+
+		OrgsClaimLimit: cfg.JWT.User.AccessToken.OrgsClaimLimit,
+	})
+
+	passwordHasher := passhash.NewHasher(passhash.Config{
+		Memory:      cfg.Passwords.Argon2id.Memory,
+		Iterations:  cfg.Passwords.Argon2id.Iterations,
+		Parallelism: cfg.Passwords.Argon2id.Parallelism,
+		SaltLength:  cfg.Passwords.Argon2id.SaltLength,
+		KeyLength:   cfg.Passwords.Argon2id.KeyLength,
+	})
+
+	breachedPasswords, err := breached.NewChecker(breached.Config{
+		RangesDir:       cfg.Passwords.Breached.RangesDir,
+		BloomFilterPath: cfg.Passwords.Breached.BloomFilter,
+	})
+	if err != nil {
+		log.Fatal("failed to load breached passwords corpus", "error", err)
+	}
+
+	confusablesTable, err := confusables.NewTable(confusables.Config{
+		File: cfg.Usernames.ConfusablesFile,
 	})
+	if err != nil {
+		log.Fatal("failed to load confusables table", "error", err)
+	}
 
 	kafkaOutbound := outbound.New(log, pool)
 
-	accountCore := account.NewService(repo, jwtTokenManager, kafkaOutbound)
-	orgCore := organization.New(repo)
+	accountCore := account.NewService(log, repo, jwtTokenManager, passwordHasher, breachedPasswords, confusablesTable, kafkaOutbound, account.Config{
+		Sessions:        cfg.SessionsPolicy(),
+		LoginProtection: cfg.LoginLockoutPolicy(),
+		PasswordPolicy:  cfg.PasswordPolicy(),
+		UsernamePolicy:  cfg.UsernamePolicy(),
+		SecurityEvents: account.SecurityEventsConfig{
+			Retention: cfg.SecurityEvents.Retention,
+		},
+		Deletion: account.AccountDeletionConfig{
+			GracePeriod:        cfg.Accounts.Deletion.GracePeriod,
+			MembershipsTimeout: cfg.Accounts.Deletion.Saga.MembershipsTimeout,
+			MaxAttempts:        cfg.Accounts.Deletion.Saga.MaxAttempts,
+		},
+	})
+	orgCore := organization.New(repo, organization.Config{
+		Tombstones: organization.TombstonesConfig{
+			Retention: cfg.Organizations.Tombstones.Retention,
+		},
+	})
 
 	ctrl := controller.New(log, cfg.GoogleOAuth(), accountCore)
-	mdll := middlewares.New(log, cfg.JWT.User.AccessToken.SecretKey)
+	mdll := middlewares.New(log, middlewares.Config{
+		AccountAccessSK:              cfg.JWT.User.AccessToken.SecretKey,
+		PasswordChangeSK:             cfg.JWT.User.PasswordChangeToken.SecretKey,
+		SessionActivityFlushInterval: cfg.Rest.SessionActivity.FlushInterval,
+		SessionActivityMaxPending:    cfg.Rest.SessionActivity.MaxPending,
+		TrustedProxies:               cfg.Rest.TrustedProxies,
+	}, accountCore)
 	router := rest.New(log, mdll, ctrl)
 
 	msgx := messenger.New(log, pool, cfg.Kafka.Brokers...)
@@ -61,6 +116,87 @@ func StartServices(ctx context.Context, cfg Config, log *logium.Logger, wg *sync
 			TimeoutReadHeader: cfg.Rest.Timeouts.ReadHeader,
 			TimeoutWrite:      cfg.Rest.Timeouts.Write,
 			TimeoutIdle:       cfg.Rest.Timeouts.Idle,
+			RateLimits:        cfg.RestRateLimits(),
+		})
+	})
+
+	run(func() { mdll.RunSessionActivity(ctx) })
+
+	run(func() {
+		jobs.Run(ctx, log, jobs.Config{
+			Name:     "sessions-reaper",
+			Interval: cfg.Sessions.Reaper.Interval,
+		}, func(ctx context.Context) error {
+			n, err := accountCore.ReapExpiredSessions(ctx, cfg.Sessions.Reaper.BatchSize)
+			if n > 0 {
+				log.Infof("reaped %d expired sessions", n)
+			}
+			return err
+		})
+	})
+
+	run(func() {
+		jobs.Run(ctx, log, jobs.Config{
+			Name:     "account-status-reaper",
+			Interval: cfg.Accounts.StatusReaper.Interval,
+		}, func(ctx context.Context) error {
+			n, err := accountCore.ReapExpiredAccountStatuses(ctx, cfg.Accounts.StatusReaper.BatchSize)
+			if n > 0 {
+				log.Infof("restored %d accounts with expired status", n)
+			}
+			return err
+		})
+	})
+
+	run(func() {
+		jobs.Run(ctx, log, jobs.Config{
+			Name:     "account-deletion-purge",
+			Interval: cfg.Accounts.Deletion.Purge.Interval,
+		}, func(ctx context.Context) error {
+			n, err := accountCore.PurgeDeletedAccounts(ctx, cfg.Accounts.Deletion.Purge.BatchSize)
+			if n > 0 {
+				log.Infof("purged %d accounts past their deletion grace period", n)
+			}
+			return err
+		})
+	})
+
+	run(func() {
+		jobs.Run(ctx, log, jobs.Config{
+			Name:     "login-failures-cleanup",
+			Interval: cfg.LoginProtection.Cleanup.Interval,
+		}, func(ctx context.Context) error {
+			n, err := accountCore.PruneLoginFailures(ctx)
+			if n > 0 {
+				log.Infof("pruned %d stale login failure counters", n)
+			}
+			return err
+		})
+	})
+
+	run(func() {
+		jobs.Run(ctx, log, jobs.Config{
+			Name:     "security-events-cleanup",
+			Interval: cfg.SecurityEvents.Cleanup.Interval,
+		}, func(ctx context.Context) error {
+			n, err := accountCore.PruneSecurityEvents(ctx, cfg.SecurityEvents.Cleanup.BatchSize)
+			if n > 0 {
+				log.Infof("pruned %d security events past retention", n)
+			}
+			return err
+		})
+	})
+
+	run(func() {
+		jobs.Run(ctx, log, jobs.Config{
+			Name:     "org-tombstones-cleanup",
+			Interval: cfg.Organizations.Tombstones.Cleanup.Interval,
+		}, func(ctx context.Context) error {
+			n, err := orgCore.PruneTombstones(ctx, cfg.Organizations.Tombstones.Cleanup.BatchSize)
+			if n > 0 {
+				log.Infof("pruned %d organization tombstones past retention", n)
+			}
+			return err
 		})
 	})
 
@@ -68,6 +204,6 @@ func StartServices(ctx context.Context, cfg Config, log *logium.Logger, wg *sync
 
 	run(func() { msgx.RunProducer(ctx) })
 
-	run(func() { msgx.RunConsumer(ctx, inbound.New(log, orgCore)) })
+	run(func() { msgx.RunConsumer(ctx, inbound.New(log, orgCore, accountCore)) })
 
 }
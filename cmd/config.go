@@ -4,6 +4,10 @@ import (
 	"os"
 	"time"
 
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/rest"
+	"github.com/netbill/auth-svc/internal/rest/middlewares"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"golang.org/x/oauth2"
@@ -19,6 +23,13 @@ type LogConfig struct {
 	Format string `mapstructure:"format"`
 }
 
+type RateLimitConfig struct {
+	Requests  uint          `mapstructure:"requests"`
+	Period    time.Duration `mapstructure:"period"`
+	Burst     uint          `mapstructure:"burst"`
+	ByAccount bool          `mapstructure:"by_account"`
+}
+
 type RestConfig struct {
 	Port     string `mapstructure:"port"`
 	Timeouts struct {
@@ -27,6 +38,18 @@ type RestConfig struct {
 		Write      time.Duration `mapstructure:"write"`
 		Idle       time.Duration `mapstructure:"idle"`
 	} `mapstructure:"timeouts"`
+	SessionActivity struct {
+		FlushInterval time.Duration `mapstructure:"flush_interval"`
+		MaxPending    int           `mapstructure:"max_pending"`
+	} `mapstructure:"session_activity"`
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+	RateLimits     struct {
+		Registration RateLimitConfig `mapstructure:"registration"`
+		Login        RateLimitConfig `mapstructure:"login"`
+		Google       RateLimitConfig `mapstructure:"google"`
+		Refresh      RateLimitConfig `mapstructure:"refresh"`
+		Me           RateLimitConfig `mapstructure:"me"`
+	} `mapstructure:"rate_limits"`
 }
 
 type DatabaseConfig struct {
@@ -50,25 +73,157 @@ type KafkaConfig struct {
 type JWTConfig struct {
 	User struct {
 		AccessToken struct {
-			SecretKey     string        `mapstructure:"secret_key"`
-			TokenLifetime time.Duration `mapstructure:"token_lifetime"`
+			SecretKey      string        `mapstructure:"secret_key"`
+			TokenLifetime  time.Duration `mapstructure:"token_lifetime"`
+			OrgsClaimLimit int           `mapstructure:"orgs_claim_limit"`
 		} `mapstructure:"access_token"`
 		RefreshToken struct {
 			SecretKey     string        `mapstructure:"secret_key"`
 			HashKey       string        `mapstructure:"hash_key"`
 			TokenLifetime time.Duration `mapstructure:"token_lifetime"`
 		} `mapstructure:"refresh_token"`
+		PasswordChangeToken struct {
+			SecretKey     string        `mapstructure:"secret_key"`
+			TokenLifetime time.Duration `mapstructure:"token_lifetime"`
+		} `mapstructure:"password_change_token"`
 	} `mapstructure:"user"`
 }
 
+type SessionLifetimeConfig struct {
+	Idle     time.Duration `mapstructure:"idle"`
+	Absolute time.Duration `mapstructure:"absolute"`
+}
+
+type SessionLimitConfig struct {
+	Max    uint   `mapstructure:"max"`
+	Policy string `mapstructure:"policy"`
+}
+
+type SessionsConfig struct {
+	Lifetime   SessionLifetimeConfig            `mapstructure:"lifetime"`
+	Roles      map[string]SessionLifetimeConfig `mapstructure:"roles"`
+	Limit      SessionLimitConfig               `mapstructure:"limit"`
+	RoleLimits map[string]SessionLimitConfig    `mapstructure:"role_limits"`
+	Reaper     struct {
+		Interval  time.Duration `mapstructure:"interval"`
+		BatchSize uint          `mapstructure:"batch_size"`
+	} `mapstructure:"reaper"`
+}
+
+type AccountsConfig struct {
+	StatusReaper struct {
+		Interval  time.Duration `mapstructure:"interval"`
+		BatchSize uint          `mapstructure:"batch_size"`
+	} `mapstructure:"status_reaper"`
+	Deletion struct {
+		GracePeriod time.Duration `mapstructure:"grace_period"`
+		Purge       struct {
+			Interval  time.Duration `mapstructure:"interval"`
+			BatchSize uint          `mapstructure:"batch_size"`
+		} `mapstructure:"purge"`
+		Saga struct {
+			MembershipsTimeout time.Duration `mapstructure:"memberships_timeout"`
+			MaxAttempts        uint          `mapstructure:"max_attempts"`
+		} `mapstructure:"saga"`
+	} `mapstructure:"deletion"`
+}
+
+type OrganizationsConfig struct {
+	Tombstones struct {
+		Retention time.Duration `mapstructure:"retention"`
+		Cleanup   struct {
+			Interval  time.Duration `mapstructure:"interval"`
+			BatchSize uint          `mapstructure:"batch_size"`
+		} `mapstructure:"cleanup"`
+	} `mapstructure:"tombstones"`
+}
+
+type LockoutPolicyConfig struct {
+	Threshold   uint          `mapstructure:"threshold"`
+	Window      time.Duration `mapstructure:"window"`
+	BaseLockout time.Duration `mapstructure:"base_lockout"`
+	MaxLockout  time.Duration `mapstructure:"max_lockout"`
+}
+
+type LoginProtectionConfig struct {
+	Account LockoutPolicyConfig `mapstructure:"account"`
+	IP      LockoutPolicyConfig `mapstructure:"ip"`
+	Cleanup struct {
+		Interval time.Duration `mapstructure:"interval"`
+	} `mapstructure:"cleanup"`
+}
+
+type SecurityEventsConfig struct {
+	Retention time.Duration `mapstructure:"retention"`
+	Cleanup   struct {
+		Interval  time.Duration `mapstructure:"interval"`
+		BatchSize uint          `mapstructure:"batch_size"`
+	} `mapstructure:"cleanup"`
+}
+
+type PasswordPolicyConfig struct {
+	MinLength        uint   `mapstructure:"min_length"`
+	MaxLength        uint   `mapstructure:"max_length"`
+	RequireUppercase bool   `mapstructure:"require_uppercase"`
+	RequireLowercase bool   `mapstructure:"require_lowercase"`
+	RequireDigit     bool   `mapstructure:"require_digit"`
+	RequireSymbol    bool   `mapstructure:"require_symbol"`
+	AllowUnicode     bool   `mapstructure:"allow_unicode"`
+	Symbols          string `mapstructure:"symbols"`
+}
+
+type PasswordsConfig struct {
+	Policy  PasswordPolicyConfig            `mapstructure:"policy"`
+	Roles   map[string]PasswordPolicyConfig `mapstructure:"roles"`
+	History uint                            `mapstructure:"history"`
+
+	ChangeCooldown struct {
+		Default time.Duration            `mapstructure:"default"`
+		Roles   map[string]time.Duration `mapstructure:"roles"`
+	} `mapstructure:"change_cooldown"`
+
+	Breached struct {
+		RangesDir   string `mapstructure:"ranges_dir"`
+		BloomFilter string `mapstructure:"bloom_filter"`
+	} `mapstructure:"breached"`
+
+	Argon2id struct {
+		Memory      uint32 `mapstructure:"memory"`
+		Iterations  uint32 `mapstructure:"iterations"`
+		Parallelism uint8  `mapstructure:"parallelism"`
+		SaltLength  uint32 `mapstructure:"salt_length"`
+		KeyLength   uint32 `mapstructure:"key_length"`
+	} `mapstructure:"argon2id"`
+}
+
+type UsernamesConfig struct {
+	ConfusablesFile string        `mapstructure:"confusables_file"`
+	Reserved        []string      `mapstructure:"reserved"`
+	Quarantine      time.Duration `mapstructure:"quarantine"`
+
+	ChangeCooldown struct {
+		Default time.Duration            `mapstructure:"default"`
+		Roles   map[string]time.Duration `mapstructure:"roles"`
+	} `mapstructure:"change_cooldown"`
+}
+
 type Config struct {
-	Service  ServerConfig   `mapstructure:"service"`
-	Log      LogConfig      `mapstructure:"log"`
-	Rest     RestConfig     `mapstructure:"rest"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	OAuth    OAuthConfig    `mapstructure:"oauth"`
-	Kafka    KafkaConfig    `mapstructure:"kafka"`
-	Database DatabaseConfig `mapstructure:"database"`
+	Service   ServerConfig    `mapstructure:"service"`
+	Log       LogConfig       `mapstructure:"log"`
+	Rest      RestConfig      `mapstructure:"rest"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	OAuth     OAuthConfig     `mapstructure:"oauth"`
+	Kafka     KafkaConfig     `mapstructure:"kafka"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Sessions  SessionsConfig  `mapstructure:"sessions"`
+	Accounts  AccountsConfig  `mapstructure:"accounts"`
+	Passwords PasswordsConfig `mapstructure:"passwords"`
+	Usernames UsernamesConfig `mapstructure:"usernames"`
+
+	Organizations OrganizationsConfig `mapstructure:"organizations"`
+
+	LoginProtection LoginProtectionConfig `mapstructure:"login_protection"`
+	SecurityEvents  SecurityEventsConfig  `mapstructure:"security_events"`
 }
 
 func LoadConfig() (Config, error) {
@@ -77,6 +232,7 @@ func LoadConfig() (Config, error) {
 		return Config{}, errors.New("KV_VIPER_FILE env var is not set")
 	}
 	viper.SetConfigFile(configPath)
+	viper.SetDefault("accounts.deletion.saga.memberships_timeout", 24*time.Hour)
 
 	if err := viper.ReadInConfig(); err != nil {
 		return Config{}, errors.Errorf("error reading config file: %s", err)
@@ -87,9 +243,143 @@ func LoadConfig() (Config, error) {
 		return Config{}, errors.Errorf("error unmarshalling config: %s", err)
 	}
 
+	if err := config.Validate(); err != nil {
+		return Config{}, errors.Errorf("invalid config: %s", err)
+	}
+
 	return config, nil
 }
 
+// Validate rejects settings the services and jobs can't run with.
+func (c *Config) Validate() error {
+	if c.Sessions.Reaper.Interval > 0 && c.Sessions.Reaper.BatchSize == 0 {
+		return errors.New("sessions.reaper.batch_size must be positive")
+	}
+	if c.Accounts.StatusReaper.Interval > 0 && c.Accounts.StatusReaper.BatchSize == 0 {
+		return errors.New("accounts.status_reaper.batch_size must be positive")
+	}
+	if c.Accounts.Deletion.Purge.Interval > 0 && c.Accounts.Deletion.Purge.BatchSize == 0 {
+		return errors.New("accounts.deletion.purge.batch_size must be positive")
+	}
+	if c.SecurityEvents.Cleanup.Interval > 0 && c.SecurityEvents.Retention > 0 && c.SecurityEvents.Cleanup.BatchSize == 0 {
+		return errors.New("security_events.cleanup.batch_size must be positive")
+	}
+	tombstones := c.Organizations.Tombstones
+	if tombstones.Cleanup.Interval > 0 && tombstones.Retention > 0 && tombstones.Cleanup.BatchSize == 0 {
+		return errors.New("organizations.tombstones.cleanup.batch_size must be positive")
+	}
+	if c.JWT.User.PasswordChangeToken.SecretKey == "" {
+		return errors.New("jwt.user.password_change_token.secret_key must be set")
+	}
+	if c.JWT.User.PasswordChangeToken.SecretKey == c.JWT.User.AccessToken.SecretKey {
+		return errors.New("jwt.user.password_change_token.secret_key must differ from jwt.user.access_token.secret_key")
+	}
+	if c.Accounts.Deletion.Purge.Interval > 0 && c.Accounts.Deletion.Saga.MembershipsTimeout <= 0 {
+		return errors.New("accounts.deletion.saga.memberships_timeout must be positive")
+	}
+
+	return nil
+}
+
+func (c *Config) SessionsPolicy() account.SessionsConfig {
+	roles := make(map[string]models.SessionLifetime, len(c.Sessions.Roles))
+	for role, l := range c.Sessions.Roles {
+		roles[role] = models.SessionLifetime{Idle: l.Idle, Absolute: l.Absolute}
+	}
+
+	limits := make(map[string]models.SessionLimit, len(c.Sessions.RoleLimits))
+	for role, l := range c.Sessions.RoleLimits {
+		limits[role] = models.SessionLimit{Max: l.Max, Policy: l.Policy}
+	}
+
+	return account.SessionsConfig{
+		Lifetime: models.SessionLifetime{
+			Idle:     c.Sessions.Lifetime.Idle,
+			Absolute: c.Sessions.Lifetime.Absolute,
+		},
+		RoleLifetimes: roles,
+		Limit: models.SessionLimit{
+			Max:    c.Sessions.Limit.Max,
+			Policy: c.Sessions.Limit.Policy,
+		},
+		RoleLimits: limits,
+	}
+}
+
+func (c *Config) LoginLockoutPolicy() account.LoginProtectionConfig {
+	policy := func(p LockoutPolicyConfig) models.LockoutPolicy {
+		return models.LockoutPolicy{
+			Threshold:   p.Threshold,
+			Window:      p.Window,
+			BaseLockout: p.BaseLockout,
+			MaxLockout:  p.MaxLockout,
+		}
+	}
+
+	return account.LoginProtectionConfig{
+		Account: policy(c.LoginProtection.Account),
+		IP:      policy(c.LoginProtection.IP),
+	}
+}
+
+func (c *Config) PasswordPolicy() account.PasswordPolicyConfig {
+	policy := func(p PasswordPolicyConfig) models.PasswordPolicy {
+		return models.PasswordPolicy{
+			MinLength:        p.MinLength,
+			MaxLength:        p.MaxLength,
+			RequireUppercase: p.RequireUppercase,
+			RequireLowercase: p.RequireLowercase,
+			RequireDigit:     p.RequireDigit,
+			RequireSymbol:    p.RequireSymbol,
+			AllowUnicode:     p.AllowUnicode,
+			Symbols:          p.Symbols,
+		}
+	}
+
+	roles := make(map[string]models.PasswordPolicy, len(c.Passwords.Roles))
+	for role, p := range c.Passwords.Roles {
+		roles[role] = policy(p)
+	}
+
+	return account.PasswordPolicyConfig{
+		Policy:       policy(c.Passwords.Policy),
+		RolePolicies: roles,
+		History:      c.Passwords.History,
+
+		ChangeCooldown:      c.Passwords.ChangeCooldown.Default,
+		RoleChangeCooldowns: c.Passwords.ChangeCooldown.Roles,
+	}
+}
+
+func (c *Config) UsernamePolicy() account.UsernamePolicyConfig {
+	return account.UsernamePolicyConfig{
+		Reserved:   c.Usernames.Reserved,
+		Quarantine: c.Usernames.Quarantine,
+
+		ChangeCooldown:      c.Usernames.ChangeCooldown.Default,
+		RoleChangeCooldowns: c.Usernames.ChangeCooldown.Roles,
+	}
+}
+
+func (c *Config) RestRateLimits() rest.RateLimits {
+	limit := func(l RateLimitConfig) middlewares.RateLimit {
+		return middlewares.RateLimit{
+			Requests:  l.Requests,
+			Period:    l.Period,
+			Burst:     l.Burst,
+			ByAccount: l.ByAccount,
+		}
+	}
+
+	return rest.RateLimits{
+		Registration: limit(c.Rest.RateLimits.Registration),
+		Login:        limit(c.Rest.RateLimits.Login),
+		Google:       limit(c.Rest.RateLimits.Google),
+		Refresh:      limit(c.Rest.RateLimits.Refresh),
+		Me:           limit(c.Rest.RateLimits.Me),
+	}
+}
+
 func (c *Config) GoogleOAuth() oauth2.Config {
 	return oauth2.Config{
 		ClientID:     c.OAuth.Google.ClientID,
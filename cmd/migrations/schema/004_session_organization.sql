@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE sessions
+    ADD COLUMN active_organization_id UUID;
+
+-- +migrate Down
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS active_organization_id;
@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE organization_members
+    ADD COLUMN position           TEXT,
+    ADD COLUMN label              TEXT,
+    ADD COLUMN source_updated_at  TIMESTAMPTZ,
+    ADD COLUMN replica_updated_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'utc');
+
+UPDATE organization_members SET source_updated_at = source_created_at;
+
+ALTER TABLE organization_members
+    ALTER COLUMN source_updated_at SET NOT NULL;
+
+CREATE TABLE organization_member_tombstones (
+    member_id  UUID        PRIMARY KEY NOT NULL,
+    deleted_at TIMESTAMPTZ NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS organization_member_tombstones;
+
+ALTER TABLE organization_members
+    DROP COLUMN IF EXISTS replica_updated_at,
+    DROP COLUMN IF EXISTS source_updated_at,
+    DROP COLUMN IF EXISTS label,
+    DROP COLUMN IF EXISTS position;
@@ -0,0 +1,8 @@
+-- +migrate Up
+CREATE TABLE organization_tombstones (
+    organization_id UUID        PRIMARY KEY NOT NULL,
+    deleted_at      TIMESTAMPTZ NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS organization_tombstones;
@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE sessions
+    ADD COLUMN ip          TEXT,
+    ADD COLUMN user_agent  TEXT,
+    ADD COLUMN os          VARCHAR(64),
+    ADD COLUMN browser     VARCHAR(64),
+    ADD COLUMN device_name VARCHAR(64);
+
+-- +migrate Down
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS device_name,
+    DROP COLUMN IF EXISTS browser,
+    DROP COLUMN IF EXISTS os,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS ip;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS version;
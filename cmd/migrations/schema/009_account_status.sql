@@ -0,0 +1,26 @@
+-- +migrate Up
+CREATE TYPE account_status AS ENUM (
+    'active',
+    'suspended',
+    'banned',
+    'pending_deletion'
+);
+
+ALTER TABLE accounts
+    ADD COLUMN status        account_status NOT NULL DEFAULT 'active',
+    ADD COLUMN status_reason TEXT,
+    ADD COLUMN status_until  TIMESTAMPTZ;
+
+CREATE INDEX idx_accounts_status_until
+    ON accounts (status_until)
+    WHERE status_until IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_accounts_status_until;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS status_until,
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS account_status;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE login_failures (
+    scope           VARCHAR(16) NOT NULL, -- account | ip
+    key             TEXT        NOT NULL,
+    failures        INT         NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    locked_until    TIMESTAMPTZ,
+
+    PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX idx_login_failures_last_failure_at
+    ON login_failures (last_failure_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS login_failures CASCADE;
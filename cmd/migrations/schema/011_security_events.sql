@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE account_security_events (
+    id         UUID        PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    type       VARCHAR(32) NOT NULL,
+    method     VARCHAR(16),
+    reason     VARCHAR(32),
+    ip         TEXT,
+    user_agent TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_account_security_events_account
+    ON account_security_events (account_id, created_at DESC);
+
+CREATE INDEX idx_account_security_events_created_at
+    ON account_security_events (created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_security_events CASCADE;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE account_known_devices (
+    account_id    UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    fingerprint   VARCHAR(64) NOT NULL,
+    ip            TEXT,
+    os            VARCHAR(64),
+    browser       VARCHAR(64),
+
+    first_seen_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_seen_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (account_id, fingerprint)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_known_devices CASCADE;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE account_password_history (
+    id         UUID        PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    hash       TEXT        NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_account_password_history_account
+    ON account_password_history (account_id, created_at DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_password_history CASCADE;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE account_passwords
+    ADD COLUMN must_change BOOLEAN     NOT NULL DEFAULT false,
+    ADD COLUMN last_change VARCHAR(16) NOT NULL DEFAULT 'owner';
+
+UPDATE account_passwords SET last_change = 'registration' WHERE updated_at = created_at;
+
+-- +migrate Down
+ALTER TABLE account_passwords
+    DROP COLUMN IF EXISTS last_change,
+    DROP COLUMN IF EXISTS must_change;
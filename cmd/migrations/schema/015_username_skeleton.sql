@@ -0,0 +1,15 @@
+-- +migrate Up
+-- Skeletons are computed by the service, `auth-svc migrate up` fills them in
+-- for accounts created before. Accounts whose username is confusable with
+-- another one stay NULL until renamed and `usernames backfill-skeletons` is run.
+ALTER TABLE accounts
+    ADD COLUMN username_skeleton TEXT UNIQUE;
+
+-- Usernames of accounts without a skeleton are compared in any case.
+CREATE INDEX accounts_username_lower_idx ON accounts (lower(username));
+
+-- +migrate Down
+DROP INDEX IF EXISTS accounts_username_lower_idx;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS username_skeleton;
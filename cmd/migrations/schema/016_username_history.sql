@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE account_username_history (
+    id                UUID        PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    account_id        UUID        NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    username          VARCHAR(32) NOT NULL,
+    username_skeleton TEXT        NOT NULL,
+
+    changed_at        TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_account_username_history_account
+    ON account_username_history (account_id, changed_at DESC);
+CREATE INDEX idx_account_username_history_username
+    ON account_username_history (username, changed_at DESC);
+CREATE INDEX idx_account_username_history_skeleton
+    ON account_username_history (username_skeleton, changed_at DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_username_history CASCADE;
@@ -0,0 +1,41 @@
+-- +migrate Up
+CREATE EXTENSION IF NOT EXISTS citext;
+
+-- Emails that only differ in case or surrounding spaces would break the
+-- unique constraint once compared case-insensitively. They are listed and
+-- the migration is aborted, so they can be resolved before running it again.
+-- +migrate StatementBegin
+DO $$
+DECLARE
+    report TEXT;
+BEGIN
+    SELECT string_agg(format('%s: %s', normalized, accounts), E'\n' ORDER BY normalized)
+    INTO report
+    FROM (
+        SELECT lower(btrim(email)) AS normalized,
+               string_agg(format('%s <%s>', account_id, email), ', ' ORDER BY created_at) AS accounts
+        FROM account_emails
+        GROUP BY lower(btrim(email))
+        HAVING count(*) > 1
+    ) AS collisions;
+
+    IF report IS NOT NULL THEN
+        RAISE EXCEPTION 'account emails collide when compared case-insensitively, resolve them and migrate again'
+            USING DETAIL = report;
+    END IF;
+END
+$$;
+-- +migrate StatementEnd
+
+ALTER TABLE account_emails ALTER COLUMN email TYPE CITEXT;
+
+UPDATE account_emails SET email = lower(btrim(email)) WHERE email::TEXT <> lower(btrim(email));
+
+ALTER TABLE account_emails
+    ADD CONSTRAINT account_emails_email_length CHECK (char_length(email) <= 255);
+
+-- +migrate Down
+ALTER TABLE account_emails DROP CONSTRAINT IF EXISTS account_emails_email_length;
+ALTER TABLE account_emails ALTER COLUMN email TYPE VARCHAR(255);
+
+DROP EXTENSION IF EXISTS citext;
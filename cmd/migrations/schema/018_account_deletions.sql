@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE account_deletions (
+    account_id   UUID        PRIMARY KEY NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    purge_after  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_account_deletions_purge_after ON account_deletions (purge_after);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_deletions CASCADE;
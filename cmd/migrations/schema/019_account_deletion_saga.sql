@@ -0,0 +1,27 @@
+-- +migrate Up
+CREATE TYPE account_deletion_state AS ENUM (
+    'scheduled',
+    'awaiting_memberships',
+    'failed'
+);
+
+ALTER TABLE account_deletions
+    ADD COLUMN state      account_deletion_state NOT NULL DEFAULT 'scheduled',
+    ADD COLUMN attempts   INTEGER                NOT NULL DEFAULT 0,
+    ADD COLUMN timeout_at TIMESTAMPTZ,
+    ADD COLUMN updated_at TIMESTAMPTZ            NOT NULL DEFAULT now();
+
+CREATE INDEX idx_account_deletions_timeout_at
+    ON account_deletions (timeout_at)
+    WHERE state = 'awaiting_memberships';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_account_deletions_timeout_at;
+
+ALTER TABLE account_deletions
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS timeout_at,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS state;
+
+DROP TYPE IF EXISTS account_deletion_state;
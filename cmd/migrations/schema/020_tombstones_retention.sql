@@ -0,0 +1,10 @@
+-- +migrate Up
+CREATE INDEX idx_organization_member_tombstones_deleted_at
+    ON organization_member_tombstones (deleted_at);
+
+CREATE INDEX idx_organization_tombstones_deleted_at
+    ON organization_tombstones (deleted_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_organization_tombstones_deleted_at;
+DROP INDEX IF EXISTS idx_organization_member_tombstones_deleted_at;
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/core/modules/organization"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/auth-svc/internal/messenger/outbound"
+	"github.com/netbill/auth-svc/internal/repository"
+	"github.com/netbill/logium"
+	"github.com/pkg/errors"
+)
+
+// ReconcileOrgMembers brings the organization members replica in line with
+// a JSON Lines snapshot file taken at the given RFC 3339 time. Deletions of
+// accounts that lost their last membership go on afterwards.
+func ReconcileOrgMembers(ctx context.Context, cfg Config, log *logium.Logger, snapshotPath, takenAt string) error {
+	snapshotTime, err := time.Parse(time.RFC3339, takenAt)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse snapshot time")
+	}
+
+	members, err := readOrgMembersSnapshot(snapshotPath)
+	if err != nil {
+		return err
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.Database.SQL.URL)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to database")
+	}
+	defer pool.Close()
+
+	repo := repository.New(pool)
+
+	res, err := organization.New(repo, organization.Config{}).ReconcileMembers(ctx, snapshotTime, members)
+	if err != nil {
+		return errors.Wrap(err, "failed to reconcile organization members")
+	}
+
+	log.WithField("replicated", res.Replicated).
+		WithField("skipped", res.Skipped).
+		WithField("removed", res.Removed).
+		Info("organization members replica reconciled")
+
+	// Only the repository and the outbox are used to continue deletions.
+	accountCore := account.NewService(log, repo, nil, nil, nil, nil, outbound.New(log, pool), account.Config{})
+	for _, accountID := range res.RemovedAccounts {
+		if err = accountCore.ContinueAccountDeletion(ctx, accountID); err != nil {
+			log.WithError(err).Errorf("failed to continue deletion of account %s", accountID)
+		}
+	}
+
+	return nil
+}
+
+func readOrgMembersSnapshot(path string) ([]models.Member, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open snapshot file")
+	}
+	defer file.Close()
+
+	members := make([]models.Member, 0)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var item contracts.OrgMemberSnapshot
+		if err = json.Unmarshal(scanner.Bytes(), &item); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode snapshot line %d", line)
+		}
+
+		members = append(members, models.Member{
+			ID:             item.MemberID,
+			AccountID:      item.AccountID,
+			OrganizationID: item.OrganizationID,
+			Position:       item.Position,
+			Label:          item.Label,
+			CreatedAt:      item.CreatedAt,
+			UpdatedAt:      item.UpdatedAt,
+		})
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot file")
+	}
+
+	return members, nil
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/netbill/auth-svc/internal/confusables"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/repository"
+	"github.com/netbill/logium"
+	"github.com/pkg/errors"
+)
+
+// BackfillUsernameSkeletons stores username skeletons of accounts created
+// before they were tracked. It lists accounts that have to be renamed and
+// fails while there are any, as the email migration does for colliding
+// emails, so they are not forgotten.
+func BackfillUsernameSkeletons(ctx context.Context, cfg Config, log *logium.Logger, batchSize uint) error {
+	if batchSize == 0 {
+		return errors.New("batch size must be positive")
+	}
+
+	table, err := confusables.NewTable(confusables.Config{File: cfg.Usernames.ConfusablesFile})
+	if err != nil {
+		return errors.Wrap(err, "failed to load confusables table")
+	}
+
+	pool, err := pgxpool.New(ctx, cfg.Database.SQL.URL)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to database")
+	}
+	defer pool.Close()
+
+	// Only the repository and the confusables table are used here.
+	core := account.NewService(log, repository.New(pool), nil, nil, nil, table, nil, account.Config{
+		UsernamePolicy: cfg.UsernamePolicy(),
+	})
+
+	filled, conflicts, err := core.BackfillUsernameSkeletons(ctx, batchSize)
+	if err != nil {
+		return errors.Wrap(err, "failed to backfill username skeletons")
+	}
+
+	for _, acc := range conflicts {
+		log.WithField("account_id", acc.ID).
+			WithField("username", acc.Username).
+			Warn("username is confusable with another account's one, rename it and run the backfill again")
+	}
+
+	log.WithField("filled", filled).
+		WithField("conflicts", len(conflicts)).
+		Info("username skeletons backfilled")
+
+	if len(conflicts) > 0 {
+		return errors.Errorf("%d accounts have confusable usernames, rename them and run the backfill again", len(conflicts))
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package breached
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+var bloomFilterMagic = [8]byte{'N', 'B', 'P', 'W', 'B', 'F', '0', '1'}
+
+// BloomFilter keeps SHA-1 hashes of breached passwords. Lookups may report a
+// password that was never added with the false positive rate the filter was
+// sized for, but never miss one that was.
+type BloomFilter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint32
+}
+
+// NewBloomFilter sizes a filter for n hashes at the false positive rate p.
+func NewBloomFilter(n uint64, p float64) *BloomFilter {
+	if n == 0 {
+		n = 1
+	}
+	if p <= 0 || p >= 1 {
+		p = 0.001
+	}
+
+	size := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	hashes := uint32(math.Max(1, math.Round(float64(size)/float64(n)*math.Ln2)))
+
+	return &BloomFilter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+// The hash is already uniformly distributed, so its halves serve as the two
+// base hashes every filter position is derived from.
+func (f *BloomFilter) positions(sum [20]byte, fn func(pos uint64) bool) {
+	h1 := binary.LittleEndian.Uint64(sum[0:8])
+	h2 := binary.LittleEndian.Uint64(sum[8:16]) | 1
+
+	for i := uint64(0); i < uint64(f.hashes); i++ {
+		if !fn((h1 + i*h2) % f.size) {
+			return
+		}
+	}
+}
+
+func (f *BloomFilter) Add(sum [20]byte) {
+	f.positions(sum, func(pos uint64) bool {
+		f.bits[pos/64] |= 1 << (pos % 64)
+		return true
+	})
+}
+
+func (f *BloomFilter) Contains(sum [20]byte) bool {
+	found := true
+	f.positions(sum, func(pos uint64) bool {
+		found = f.bits[pos/64]&(1<<(pos%64)) != 0
+		return found
+	})
+
+	return found
+}
+
+func (f *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+
+	header := make([]byte, 0, len(bloomFilterMagic)+12)
+	header = append(header, bloomFilterMagic[:]...)
+	header = binary.LittleEndian.AppendUint64(header, f.size)
+	header = binary.LittleEndian.AppendUint32(header, f.hashes)
+
+	written, err := bw.Write(header)
+	if err != nil {
+		return int64(written), err
+	}
+
+	var word [8]byte
+	for _, bits := range f.bits {
+		binary.LittleEndian.PutUint64(word[:], bits)
+		n, err := bw.Write(word[:])
+		written += n
+		if err != nil {
+			return int64(written), err
+		}
+	}
+
+	return int64(written), bw.Flush()
+}
+
+// ReadBloomFilter reads a filter written by WriteTo. The length of the whole
+// filter is required up front, the size in the header is not trusted until
+// it matches.
+func ReadBloomFilter(r io.Reader, length int64) (*BloomFilter, error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, len(bloomFilterMagic)+12)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("reading filter header: %w", err)
+	}
+	if [8]byte(header[:8]) != bloomFilterMagic {
+		return nil, errors.New("not a breached passwords filter")
+	}
+
+	f := &BloomFilter{
+		size:   binary.LittleEndian.Uint64(header[8:16]),
+		hashes: binary.LittleEndian.Uint32(header[16:20]),
+	}
+	if f.size == 0 || f.hashes == 0 {
+		return nil, errors.New("filter header is corrupted")
+	}
+
+	words := f.size / 64
+	if f.size%64 != 0 {
+		words++
+	}
+
+	payload := length - int64(len(header))
+	if payload < 0 || payload%8 != 0 || uint64(payload/8) != words {
+		return nil, fmt.Errorf("filter header declares %d bits, but %d bytes follow it", f.size, payload)
+	}
+
+	f.bits = make([]uint64, words)
+
+	var word [8]byte
+	for i := range f.bits {
+		if _, err := io.ReadFull(br, word[:]); err != nil {
+			return nil, fmt.Errorf("reading filter bits: %w", err)
+		}
+		f.bits[i] = binary.LittleEndian.Uint64(word[:])
+	}
+
+	return f, nil
+}
@@ -0,0 +1,101 @@
+package breached
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBloomFilterRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         uint64
+		p         float64
+		passwords []string
+	}{
+		{name: "empty filter", n: 10, p: 0.01},
+		{name: "single password", n: 1, p: 0.01, passwords: []string{"password"}},
+		{name: "size not a multiple of 64", n: 3, p: 0.1, passwords: []string{"a", "b", "c"}},
+		{name: "many passwords", n: 1000, p: 0.001, passwords: testPasswords(1000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewBloomFilter(tt.n, tt.p)
+			for _, password := range tt.passwords {
+				f.Add(sha1.Sum([]byte(password)))
+			}
+
+			var buf bytes.Buffer
+			written, err := f.WriteTo(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if written != int64(buf.Len()) {
+				t.Errorf("WriteTo() = %d, but wrote %d bytes", written, buf.Len())
+			}
+
+			read, err := ReadBloomFilter(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				t.Fatalf("ReadBloomFilter() error = %v", err)
+			}
+			if !reflect.DeepEqual(read, f) {
+				t.Fatal("filter read back differs from the written one")
+			}
+
+			for _, password := range tt.passwords {
+				if !read.Contains(sha1.Sum([]byte(password))) {
+					t.Errorf("Contains(%q) = false after round trip", password)
+				}
+			}
+		})
+	}
+}
+
+func TestReadBloomFilterRejects(t *testing.T) {
+	var valid bytes.Buffer
+	if _, err := NewBloomFilter(100, 0.01).WriteTo(&valid); err != nil {
+		t.Fatal(err)
+	}
+
+	withSize := func(size uint64) []byte {
+		data := bytes.Clone(valid.Bytes())
+		binary.LittleEndian.PutUint64(data[8:16], size)
+		return data
+	}
+
+	tests := []struct {
+		name   string
+		data   []byte
+		length int64
+	}{
+		{name: "empty", data: nil, length: 0},
+		{name: "short header", data: valid.Bytes()[:10], length: 10},
+		{name: "wrong magic", data: append([]byte("NOTAFILT"), valid.Bytes()[8:]...), length: int64(valid.Len())},
+		{name: "zero size", data: withSize(0), length: int64(valid.Len())},
+		{name: "size larger than the file", data: withSize(1 << 62), length: int64(valid.Len())},
+		{name: "size smaller than the file", data: withSize(64), length: int64(valid.Len())},
+		{name: "truncated bits", data: valid.Bytes()[:valid.Len()-8], length: int64(valid.Len() - 8)},
+		{name: "length shorter than the header", data: valid.Bytes(), length: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadBloomFilter(bytes.NewReader(tt.data), tt.length); err == nil {
+				t.Error("ReadBloomFilter() accepted a corrupted filter")
+			}
+		})
+	}
+}
+
+func testPasswords(n int) []string {
+	passwords := make([]string, 0, n)
+	for i := range n {
+		passwords = append(passwords, fmt.Sprintf("password-%d", i))
+	}
+
+	return passwords
+}
@@ -0,0 +1,37 @@
+package breached
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ParseDumpLine turns a line of a breached passwords dump into the SHA-1 hash
+// of the password. Plain dumps hold a password per line, hash dumps hold a hex
+// SHA-1 hash optionally followed by ":count" as in Have I Been Pwned exports.
+// Empty lines are skipped with ok set to false.
+func ParseDumpLine(line string, plain bool) (sum [20]byte, ok bool, err error) {
+	if plain {
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			return sum, false, nil
+		}
+
+		return sha1.Sum([]byte(line)), true, nil
+	}
+
+	hash, _, _ := strings.Cut(strings.TrimSpace(line), ":")
+	if hash == "" {
+		return sum, false, nil
+	}
+	if len(hash) != hex.EncodedLen(len(sum)) {
+		return sum, false, fmt.Errorf("invalid SHA-1 hash length %d", len(hash))
+	}
+
+	if _, err = hex.Decode(sum[:], []byte(hash)); err != nil {
+		return sum, false, fmt.Errorf("invalid SHA-1 hash, cause: %w", err)
+	}
+
+	return sum, true, nil
+}
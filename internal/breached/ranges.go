@@ -0,0 +1,53 @@
+package breached
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const rangePrefixLength = 5
+
+// inRanges looks the hash up in its range file, a missing file means no
+// password of that range is known.
+func (c Checker) inRanges(sum [20]byte) (bool, error) {
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:rangePrefixLength], hash[rangePrefixLength:]
+
+	for _, name := range []string{prefix + ".txt", prefix} {
+		file, err := os.Open(filepath.Join(c.rangesDir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to open range file %s, cause: %w", name, err)
+		}
+
+		found, err := scanRange(file, suffix)
+		_ = file.Close()
+		if err != nil {
+			return false, fmt.Errorf("failed to read range file %s, cause: %w", name, err)
+		}
+
+		return found, nil
+	}
+
+	return false, nil
+}
+
+func scanRange(file *os.File, suffix string) (bool, error) {
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), ":")
+		if strings.EqualFold(strings.TrimSpace(line), suffix) {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
@@ -0,0 +1,73 @@
+package breached
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"os"
+)
+
+// Checker tells whether a password is known to be compromised. It never goes
+// to the network: the corpus is either a directory of SHA-1 range files as
+// served by the Have I Been Pwned range API, or a bloom filter built from a
+// dump with the CLI. With both configured a password is breached when any of
+// them knows it, with none every password passes.
+type Checker struct {
+	rangesDir string
+	filter    *BloomFilter
+}
+
+type Config struct {
+	// RangesDir holds files named by the first 5 hex characters of the
+	// SHA-1 hash, each line is the rest of the hash and a count.
+	RangesDir string
+	// BloomFilterPath is a filter file built with the CLI.
+	BloomFilterPath string
+}
+
+func NewChecker(cfg Config) (Checker, error) {
+	c := Checker{rangesDir: cfg.RangesDir}
+
+	if cfg.RangesDir != "" {
+		info, err := os.Stat(cfg.RangesDir)
+		if err != nil {
+			return Checker{}, fmt.Errorf("failed to open breached passwords ranges dir, cause: %w", err)
+		}
+		if !info.IsDir() {
+			return Checker{}, fmt.Errorf("breached passwords ranges path %s is not a directory", cfg.RangesDir)
+		}
+	}
+
+	if cfg.BloomFilterPath != "" {
+		file, err := os.Open(cfg.BloomFilterPath)
+		if err != nil {
+			return Checker{}, fmt.Errorf("failed to open breached passwords filter, cause: %w", err)
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return Checker{}, fmt.Errorf("failed to stat breached passwords filter, cause: %w", err)
+		}
+
+		c.filter, err = ReadBloomFilter(file, info.Size())
+		if err != nil {
+			return Checker{}, fmt.Errorf("failed to read breached passwords filter, cause: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+func (c Checker) IsBreached(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+
+	if c.filter != nil && c.filter.Contains(sum) {
+		return true, nil
+	}
+
+	if c.rangesDir != "" {
+		return c.inRanges(sum)
+	}
+
+	return false, nil
+}
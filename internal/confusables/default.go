@@ -0,0 +1,83 @@
+package confusables
+
+// Default is the part of the UTS #39 confusables data that maps letters of
+// other scripts and ASCII look-alikes to Latin letters, which is what an
+// impersonation of a Latin name is made of. It is meant for case-folded
+// input, so lowercase letters also stand in for their capitals. Load the
+// full confusables.txt to cover every script.
+func Default() Table {
+	prototypes := make(map[rune]string, len(defaultPrototypes))
+	for r, p := range defaultPrototypes {
+		prototypes[r] = p
+	}
+
+	return Table{prototypes: prototypes}
+}
+
+var defaultPrototypes = map[rune]string{
+	// ASCII
+	'0': "o",
+	'1': "l",
+	'|': "l",
+	'm': "rn",
+
+	// Latin
+	'ı': "i",
+	'ɑ': "a",
+	'ɡ': "g",
+	'ǀ': "l",
+	'ɩ': "i",
+	'ʋ': "u",
+
+	// Cyrillic
+	'а': "a",
+	'в': "b",
+	'е': "e",
+	'к': "k",
+	'м': "rn",
+	'н': "h",
+	'о': "o",
+	'р': "p",
+	'с': "c",
+	'т': "t",
+	'у': "y",
+	'х': "x",
+	'ѕ': "s",
+	'і': "i",
+	'ј': "j",
+	'ԁ': "d",
+	'һ': "h",
+	'ԛ': "q",
+	'ԝ': "w",
+	'ӏ': "l",
+	'ү': "y",
+	'ь': "b",
+	'ѵ': "v",
+
+	// Greek
+	'α': "a",
+	'β': "b",
+	'γ': "y",
+	'ε': "e",
+	'ζ': "z",
+	'η': "n",
+	'ι': "i",
+	'κ': "k",
+	'μ': "u",
+	'ν': "v",
+	'ο': "o",
+	'ρ': "p",
+	'σ': "o",
+	'τ': "t",
+	'υ': "u",
+	'χ': "x",
+
+	// Armenian
+	'ա': "w",
+	'զ': "q",
+	'հ': "h",
+	'ո': "n",
+	'ս': "u",
+	'ց': "g",
+	'օ': "o",
+}
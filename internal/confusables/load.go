@@ -0,0 +1,75 @@
+package confusables
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Load reads a confusables.txt, where each line maps a source character to
+// its prototype:
+//
+//	0430 ;	0061 ;	MA	# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A
+func Load(path string) (Table, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Table{}, fmt.Errorf("failed to open confusables file, cause: %w", err)
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+func Parse(r io.Reader) (Table, error) {
+	t := Table{prototypes: make(map[rune]string)}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		text = strings.TrimPrefix(strings.TrimSpace(text), "\ufeff")
+		if text == "" {
+			continue
+		}
+
+		fields := strings.Split(text, ";")
+		if len(fields) < 2 {
+			return Table{}, fmt.Errorf("malformed confusables line %d", line)
+		}
+
+		source, err := parseCodePoints(fields[0])
+		if err != nil || len([]rune(source)) != 1 {
+			return Table{}, fmt.Errorf("malformed source on confusables line %d", line)
+		}
+
+		prototype, err := parseCodePoints(fields[1])
+		if err != nil {
+			return Table{}, fmt.Errorf("malformed prototype on confusables line %d, cause: %w", line, err)
+		}
+
+		t.prototypes[[]rune(source)[0]] = prototype
+	}
+	if err := scanner.Err(); err != nil {
+		return Table{}, fmt.Errorf("failed to read confusables file, cause: %w", err)
+	}
+
+	return t, nil
+}
+
+func parseCodePoints(field string) (string, error) {
+	var b strings.Builder
+	for _, hex := range strings.Fields(field) {
+		cp, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return "", err
+		}
+		b.WriteRune(rune(cp))
+	}
+	if b.Len() == 0 {
+		return "", fmt.Errorf("no code points")
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,44 @@
+package confusables
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Table maps characters to the prototypes they can be mistaken for, as
+// defined by the confusables data of Unicode Technical Standard #39.
+type Table struct {
+	prototypes map[rune]string
+}
+
+type Config struct {
+	// File is a confusables.txt from the Unicode data files, the built-in
+	// table covering look-alikes of Latin letters and digits is used when
+	// empty.
+	File string
+}
+
+func NewTable(cfg Config) (Table, error) {
+	if cfg.File == "" {
+		return Default(), nil
+	}
+
+	return Load(cfg.File)
+}
+
+// Skeleton implements the skeleton function of UTS #39: two strings are
+// confusable when their skeletons are equal. The skeleton is meant for
+// comparison only and must never be shown.
+func (t Table) Skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if p, ok := t.prototypes[r]; ok {
+			b.WriteString(p)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return norm.NFD.String(b.String())
+}
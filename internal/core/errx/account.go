@@ -6,8 +6,19 @@ import (
 
 var ErrorAccountNotFound = ape.DeclareError("ACCOUNT_NOT_FOUND")
 
+var ErrorAccountSuspended = ape.DeclareError("ACCOUNT_SUSPENDED")
+var ErrorAccountBanned = ape.DeclareError("ACCOUNT_BANNED")
+var ErrorAccountPendingDeletion = ape.DeclareError("ACCOUNT_PENDING_DELETION")
+var ErrorAccountDeletionNotFound = ape.DeclareError("ACCOUNT_DELETION_NOT_FOUND")
+var ErrorAccountStatusNotSupported = ape.DeclareError("ACCOUNT_STATUS_NOT_SUPPORTED")
+var ErrorAccountStatusUntilInvalid = ape.DeclareError("ACCOUNT_STATUS_UNTIL_INVALID")
+
+var ErrorAccountTemporarilyLocked = ape.DeclareError("ACCOUNT_TEMPORARILY_LOCKED")
+
 var ErrorUsernameAlreadyTaken = ape.DeclareError("USERNAME_ALREADY_TAKEN")
 var ErrorUsernameIsNotAllowed = ape.DeclareError("USERNAME_IS_NOT_ALLOWED")
+var ErrorUsernameChangeNotFound = ape.DeclareError("USERNAME_CHANGE_NOT_FOUND")
+var ErrorCannotChangeUsernameYet = ape.DeclareError("CANNOT_CHANGE_USERNAME_YET")
 
 var ErrorInitiatorNotFound = ape.DeclareError("INITIATOR_NOT_FOUND")
 var ErrorInitiatorInvalidSession = ape.DeclareError("INITIATOR_INVALID_SESSION")
@@ -23,6 +34,7 @@ var ErrorPasswordInvalid = ape.DeclareError("PASSWORD_INVALID")
 var ErrorPasswordIsNotAllowed = ape.DeclareError("PASSWORD_IS_NOT_ALLOWED")
 
 var ErrorCannotChangePasswordYet = ape.DeclareError("CANNOT_CHANGE_PASSWORD_YET")
+var ErrorPasswordChangeRequired = ape.DeclareError("PASSWORD_CHANGE_REQUIRED")
 
 var ErrorRoleNotSupported = ape.DeclareError("ACCOUNT_ROLE_NOT_SUPPORTED")
 var AccountHaveMembershipInOrg = ape.DeclareError("CANNOT_DELETE_ACCOUNT_ORG_MEMBER")
@@ -0,0 +1,8 @@
+package errx
+
+import (
+	"github.com/netbill/ape"
+)
+
+var ErrorOrgMemberNotFound = ape.DeclareError("ORGANIZATION_MEMBER_NOT_FOUND")
+var ErrorOrgMemberDeleted = ape.DeclareError("ORGANIZATION_MEMBER_DELETED")
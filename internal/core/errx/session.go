@@ -7,3 +7,7 @@ import (
 var ErrorSessionNotFound = ape.DeclareError("SESSION_NOT_FOUND")
 
 var ErrorSessionTokenMismatch = ape.DeclareError("SESSION_TOKEN_MISMATCH")
+
+var ErrorSessionExpired = ape.DeclareError("SESSION_EXPIRED")
+
+var ErrorSessionLimitReached = ape.DeclareError("SESSION_LIMIT_REACHED")
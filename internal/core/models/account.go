@@ -1,16 +1,14 @@
 package models
 
 import (
-	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/errx"
-	"golang.org/x/crypto/bcrypt"
 )
 
-const updatePasswordCooldown = 30 * 24 * time.Hour
 const updateEmailCooldown = 30 * 24 * time.Hour
 
 type Account struct {
@@ -18,19 +16,104 @@ type Account struct {
 	Username string    `json:"username"`
 	Role     string    `json:"role"`
 
+	Status       string     `json:"status"`
+	StatusReason *string    `json:"status_reason,omitempty"`
+	StatusUntil  *time.Time `json:"status_until,omitempty"`
+
+	// Version grows with every change of the account, consumers of account
+	// events use it to drop stale updates.
+	Version int64 `json:"version"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+const (
+	AccountStatusActive          = "active"
+	AccountStatusSuspended       = "suspended"
+	AccountStatusBanned          = "banned"
+	AccountStatusPendingDeletion = "pending_deletion"
+)
+
+func GetAllAccountStatuses() []string {
+	return []string{
+		AccountStatusActive,
+		AccountStatusSuspended,
+		AccountStatusBanned,
+		AccountStatusPendingDeletion,
+	}
+}
+
+// EffectiveStatus is the status the account has at the moment t, a temporary
+// status is over once its StatusUntil passes even if the row is not reset yet.
+func (a Account) EffectiveStatus(t time.Time) string {
+	if a.Status == "" {
+		return AccountStatusActive
+	}
+	if a.StatusUntil != nil && !t.Before(*a.StatusUntil) {
+		return AccountStatusActive
+	}
+
+	return a.Status
+}
+
+// CanSignIn reports whether the account may open or refresh sessions.
+func (a Account) CanSignIn(t time.Time) error {
+	switch a.EffectiveStatus(t) {
+	case AccountStatusActive:
+		return nil
+	case AccountStatusSuspended:
+		return errx.ErrorAccountSuspended.Raise(
+			fmt.Errorf("account %s is suspended", a.ID),
+		)
+	case AccountStatusBanned:
+		return errx.ErrorAccountBanned.Raise(
+			fmt.Errorf("account %s is banned", a.ID),
+		)
+	case AccountStatusPendingDeletion:
+		return errx.ErrorAccountPendingDeletion.Raise(
+			fmt.Errorf("account %s is pending deletion", a.ID),
+		)
+	default:
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("account %s has unknown status %s", a.ID, a.Status),
+		)
+	}
+}
+
+// AccountDetails is the extended view of an account for administrators.
+type AccountDetails struct {
+	Account       Account
+	Email         AccountEmail
+	SessionsCount uint
+}
+
+// How the current password of an account was set.
+const (
+	PasswordChangeRegistration = "registration"
+	PasswordChangeOwner        = "owner"
+	PasswordChangeForced       = "forced"
+)
+
 type AccountPassword struct {
 	AccountID uuid.UUID `json:"account_id"`
 	Hash      string    `json:"hash"`
-	UpdatedAt time.Time `json:"updated_at"`
-	CreatedAt time.Time `json:"created_at"`
+	// MustChange is set by an admin, the owner has to change the password
+	// before the account can be used again.
+	MustChange bool      `json:"must_change"`
+	LastChange string    `json:"last_change"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
-func (ap AccountPassword) CanChangePassword() error {
-	if time.Since(ap.UpdatedAt) >= updatePasswordCooldown {
+// CanChangePassword enforces the cooldown between two password changes made
+// by the owner at will. Passwords set on registration and changes forced by
+// an admin are not subject to it.
+func (ap AccountPassword) CanChangePassword(cooldown time.Duration, now time.Time) error {
+	if cooldown <= 0 || ap.MustChange || ap.LastChange != PasswordChangeOwner {
+		return nil
+	}
+	if now.Sub(ap.UpdatedAt) >= cooldown {
 		return nil
 	}
 
@@ -39,20 +122,9 @@ func (ap AccountPassword) CanChangePassword() error {
 	)
 }
 
-func (ap AccountPassword) CheckPasswordMatch(password string) error {
-	if err := bcrypt.CompareHashAndPassword([]byte(ap.Hash), []byte(password)); err != nil {
-		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			return errx.ErrorPasswordInvalid.Raise(
-				fmt.Errorf("invalid credentials, cause: %w", err),
-			)
-		}
-
-		return errx.ErrorInternal.Raise(
-			fmt.Errorf("comparing password hash, cause: %w", err),
-		)
-	}
-
-	return nil
+// NormalizeEmail is the form emails are stored and looked up in.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
 }
 
 type AccountEmail struct {
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// States of an account deletion. A scheduled deletion waits for its grace
+// period to end, then the account is deleted right away unless it is a member
+// of organizations, in which case the organization service is asked to remove
+// the memberships first. A failed deletion is kept until the owner restores
+// the account by logging in or an administrator deletes it.
+const (
+	AccountDeletionScheduled           = "scheduled"
+	AccountDeletionAwaitingMemberships = "awaiting_memberships"
+	AccountDeletionFailed              = "failed"
+)
+
+// AccountDeletion is a requested deletion of an account, the account stays
+// pending deletion and can be restored by its owner until PurgeAfter or once
+// the deletion failed.
+type AccountDeletion struct {
+	AccountID   uuid.UUID  `json:"account_id"`
+	State       string     `json:"state"`
+	Attempts    uint       `json:"attempts"`
+	RequestedAt time.Time  `json:"requested_at"`
+	PurgeAfter  time.Time  `json:"purge_after"`
+	TimeoutAt   *time.Time `json:"timeout_at,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// Due reports whether the deletion has to move on at t, either its grace
+// period is over or the wait for memberships removal timed out.
+func (d AccountDeletion) Due(t time.Time) bool {
+	switch d.State {
+	case AccountDeletionScheduled:
+		return !t.Before(d.PurgeAfter)
+	case AccountDeletionAwaitingMemberships:
+		return d.TimeoutAt != nil && !t.Before(*d.TimeoutAt)
+	default:
+		return false
+	}
+}
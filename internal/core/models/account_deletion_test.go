@@ -0,0 +1,61 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccountDeletionDue(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	tests := []struct {
+		name     string
+		deletion AccountDeletion
+		want     bool
+	}{
+		{
+			name:     "scheduled within grace period",
+			deletion: AccountDeletion{State: AccountDeletionScheduled, PurgeAfter: future},
+		},
+		{
+			name:     "scheduled at the end of grace period",
+			deletion: AccountDeletion{State: AccountDeletionScheduled, PurgeAfter: now},
+			want:     true,
+		},
+		{
+			name:     "scheduled past grace period",
+			deletion: AccountDeletion{State: AccountDeletionScheduled, PurgeAfter: past},
+			want:     true,
+		},
+		{
+			name:     "awaiting memberships before timeout",
+			deletion: AccountDeletion{State: AccountDeletionAwaitingMemberships, PurgeAfter: past, TimeoutAt: &future},
+		},
+		{
+			name:     "awaiting memberships at timeout",
+			deletion: AccountDeletion{State: AccountDeletionAwaitingMemberships, PurgeAfter: past, TimeoutAt: &now},
+			want:     true,
+		},
+		{
+			name:     "awaiting memberships without timeout",
+			deletion: AccountDeletion{State: AccountDeletionAwaitingMemberships, PurgeAfter: past},
+		},
+		{
+			name:     "failed",
+			deletion: AccountDeletion{State: AccountDeletionFailed, PurgeAfter: past, TimeoutAt: &past},
+		},
+		{
+			name:     "unknown state",
+			deletion: AccountDeletion{State: "unknown", PurgeAfter: past},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.deletion.Due(now); got != tt.want {
+				t.Errorf("Due() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
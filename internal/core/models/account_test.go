@@ -0,0 +1,68 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/core/errx"
+)
+
+func TestAccountPasswordCanChangePassword(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		password AccountPassword
+		cooldown time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "cooldown disabled",
+			password: AccountPassword{LastChange: PasswordChangeOwner, UpdatedAt: now},
+		},
+		{
+			name:     "owner change within cooldown",
+			password: AccountPassword{LastChange: PasswordChangeOwner, UpdatedAt: now.Add(-time.Hour)},
+			cooldown: 24 * time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "owner change right at the end of cooldown",
+			password: AccountPassword{LastChange: PasswordChangeOwner, UpdatedAt: now.Add(-24 * time.Hour)},
+			cooldown: 24 * time.Hour,
+		},
+		{
+			name:     "owner change after cooldown",
+			password: AccountPassword{LastChange: PasswordChangeOwner, UpdatedAt: now.Add(-48 * time.Hour)},
+			cooldown: 24 * time.Hour,
+		},
+		{
+			name:     "password set on registration",
+			password: AccountPassword{LastChange: PasswordChangeRegistration, UpdatedAt: now},
+			cooldown: 24 * time.Hour,
+		},
+		{
+			name:     "password forced by an admin",
+			password: AccountPassword{LastChange: PasswordChangeForced, UpdatedAt: now},
+			cooldown: 24 * time.Hour,
+		},
+		{
+			name:     "change required",
+			password: AccountPassword{LastChange: PasswordChangeOwner, MustChange: true, UpdatedAt: now},
+			cooldown: 24 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.password.CanChangePassword(tt.cooldown, now)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("CanChangePassword() error = %v, want error %t", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, errx.ErrorCannotChangePasswordYet) {
+				t.Errorf("CanChangePassword() error = %v, want %v", err, errx.ErrorCannotChangePasswordYet)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KnownDevice is a device and IP combination the account has already signed
+// in from.
+type KnownDevice struct {
+	AccountID   uuid.UUID `json:"account_id"`
+	Fingerprint string    `json:"fingerprint"`
+	IP          string    `json:"ip,omitempty"`
+	OS          string    `json:"os,omitempty"`
+	Browser     string    `json:"browser,omitempty"`
+	FirstSeenAt time.Time `json:"first_seen_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+}
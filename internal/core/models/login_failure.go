@@ -0,0 +1,62 @@
+package models
+
+import "time"
+
+const (
+	LoginFailureScopeAccount = "account"
+	LoginFailureScopeIP      = "ip"
+)
+
+// LoginFailures counts failed logins for an account or a client IP.
+type LoginFailures struct {
+	Scope         string     `json:"scope"`
+	Key           string     `json:"key"`
+	Failures      uint       `json:"failures"`
+	LastFailureAt time.Time  `json:"last_failure_at"`
+	LockedUntil   *time.Time `json:"locked_until,omitempty"`
+}
+
+// Locked reports whether logins for the key are refused at the moment t.
+func (f LoginFailures) Locked(t time.Time) bool {
+	return f.LockedUntil != nil && t.Before(*f.LockedUntil)
+}
+
+// LockoutPolicy locks logins after Threshold failures made within Window.
+// Every further failure doubles the lockout starting from BaseLockout up to
+// MaxLockout, a zero MaxLockout keeps every lockout at BaseLockout.
+type LockoutPolicy struct {
+	Threshold   uint
+	Window      time.Duration
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+}
+
+func (p LockoutPolicy) Enabled() bool {
+	return p.Threshold > 0 && p.BaseLockout > 0
+}
+
+// WindowStart is the moment failures made before are not counted anymore.
+func (p LockoutPolicy) WindowStart(t time.Time) time.Time {
+	if p.Window <= 0 {
+		return time.Time{}
+	}
+
+	return t.Add(-p.Window)
+}
+
+// Lockout is how long logins are refused after the given number of failures.
+func (p LockoutPolicy) Lockout(failures uint) time.Duration {
+	if !p.Enabled() || failures < p.Threshold {
+		return 0
+	}
+
+	d := p.BaseLockout
+	for i := p.Threshold; i < failures && d < p.MaxLockout; i++ {
+		d *= 2
+	}
+	if p.MaxLockout > 0 && d > p.MaxLockout {
+		d = p.MaxLockout
+	}
+
+	return d
+}
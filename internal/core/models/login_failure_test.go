@@ -0,0 +1,81 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutPolicyLockout(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   LockoutPolicy
+		failures uint
+		want     time.Duration
+	}{
+		{
+			name:     "disabled by threshold",
+			policy:   LockoutPolicy{BaseLockout: time.Minute},
+			failures: 10,
+		},
+		{
+			name:     "disabled by base lockout",
+			policy:   LockoutPolicy{Threshold: 3},
+			failures: 10,
+		},
+		{
+			name:     "below threshold",
+			policy:   LockoutPolicy{Threshold: 3, BaseLockout: time.Minute, MaxLockout: time.Hour},
+			failures: 2,
+		},
+		{
+			name:     "at threshold",
+			policy:   LockoutPolicy{Threshold: 3, BaseLockout: time.Minute, MaxLockout: time.Hour},
+			failures: 3,
+			want:     time.Minute,
+		},
+		{
+			name:     "doubles after threshold",
+			policy:   LockoutPolicy{Threshold: 3, BaseLockout: time.Minute, MaxLockout: time.Hour},
+			failures: 4,
+			want:     2 * time.Minute,
+		},
+		{
+			name:     "keeps doubling",
+			policy:   LockoutPolicy{Threshold: 3, BaseLockout: time.Minute, MaxLockout: time.Hour},
+			failures: 6,
+			want:     8 * time.Minute,
+		},
+		{
+			name:     "capped at max lockout",
+			policy:   LockoutPolicy{Threshold: 3, BaseLockout: time.Minute, MaxLockout: time.Hour},
+			failures: 10,
+			want:     time.Hour,
+		},
+		{
+			name:     "capped far past max lockout",
+			policy:   LockoutPolicy{Threshold: 1, BaseLockout: time.Minute, MaxLockout: time.Hour},
+			failures: 1000,
+			want:     time.Hour,
+		},
+		{
+			name:     "zero max lockout keeps base lockout",
+			policy:   LockoutPolicy{Threshold: 3, BaseLockout: time.Minute},
+			failures: 10,
+			want:     time.Minute,
+		},
+		{
+			name:     "max lockout below base lockout",
+			policy:   LockoutPolicy{Threshold: 1, BaseLockout: time.Hour, MaxLockout: time.Minute},
+			failures: 1,
+			want:     time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Lockout(tt.failures); got != tt.want {
+				t.Errorf("Lockout(%d) = %s, want %s", tt.failures, got, tt.want)
+			}
+		})
+	}
+}
@@ -10,5 +10,8 @@ type Member struct {
 	ID             uuid.UUID `json:"id"`
 	AccountID      uuid.UUID `json:"account_id"`
 	OrganizationID uuid.UUID `json:"organization_id"`
+	Position       *string   `json:"position,omitempty"`
+	Label          *string   `json:"label,omitempty"`
 	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	PasswordRuleMinLength        = "min_length"
+	PasswordRuleMaxLength        = "max_length"
+	PasswordRuleUppercase        = "uppercase"
+	PasswordRuleLowercase        = "lowercase"
+	PasswordRuleDigit            = "digit"
+	PasswordRuleSymbol           = "symbol"
+	PasswordRuleInvalidCharacter = "invalid_character"
+	PasswordRuleBreached         = "breached"
+	PasswordRuleRecentlyUsed     = "recently_used"
+)
+
+// PasswordPolicy describes what a password has to look like. Lengths are
+// counted in characters, a zero MaxLength disables the upper bound.
+type PasswordPolicy struct {
+	MinLength uint
+	MaxLength uint
+
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+
+	// AllowUnicode accepts any printable character, otherwise passwords are
+	// limited to printable ASCII.
+	AllowUnicode bool
+	// Symbols restricts accepted symbols to the listed ones, any printable
+	// character that is neither a letter nor a digit is a symbol when empty.
+	Symbols string
+}
+
+// PasswordViolation is a single rule of the policy the password breaks.
+type PasswordViolation struct {
+	Rule string `json:"rule"`
+	// Limit is the length bound for the length rules and the number of
+	// remembered passwords for the recently_used rule.
+	Limit uint `json:"limit,omitempty"`
+	// Characters lists the rejected characters for the invalid_character rule.
+	Characters string `json:"characters,omitempty"`
+	// Symbols lists the accepted symbols for the symbol rule when restricted.
+	Symbols string `json:"symbols,omitempty"`
+}
+
+// Check returns every rule the password breaks, nil means the password is
+// accepted.
+func (p PasswordPolicy) Check(password string) []PasswordViolation {
+	var violations []PasswordViolation
+
+	length := uint(utf8.RuneCountInString(password))
+	if length < p.MinLength {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleMinLength, Limit: p.MinLength})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleMaxLength, Limit: p.MaxLength})
+	}
+
+	var (
+		hasUpper, hasLower, hasDigit, hasSymbol bool
+		invalid                                 strings.Builder
+	)
+
+	for _, r := range password {
+		switch {
+		case !p.allowed(r):
+			if !strings.ContainsRune(invalid.String(), r) {
+				invalid.WriteRune(r)
+			}
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			// Caseless letters and other numerals count towards no class.
+		default:
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleUppercase})
+	}
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleLowercase})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleDigit})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, PasswordViolation{Rule: PasswordRuleSymbol, Symbols: p.Symbols})
+	}
+	if invalid.Len() > 0 {
+		violations = append(violations, PasswordViolation{
+			Rule:       PasswordRuleInvalidCharacter,
+			Characters: invalid.String(),
+		})
+	}
+
+	return violations
+}
+
+func (p PasswordPolicy) allowed(r rune) bool {
+	if !unicode.IsPrint(r) {
+		return false
+	}
+	if !p.AllowUnicode && r > unicode.MaxASCII {
+		return false
+	}
+	if unicode.IsLetter(r) || unicode.IsNumber(r) {
+		return true
+	}
+
+	return p.Symbols == "" || strings.ContainsRune(p.Symbols, r)
+}
@@ -0,0 +1,119 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	strict := PasswordPolicy{
+		MinLength:        8,
+		MaxLength:        16,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		want     []PasswordViolation
+	}{
+		{
+			name:     "empty policy accepts anything printable",
+			policy:   PasswordPolicy{},
+			password: "a",
+		},
+		{
+			name:     "meets every rule",
+			policy:   strict,
+			password: "Passw0rd!",
+		},
+		{
+			name:     "too short",
+			policy:   strict,
+			password: "Pa0!",
+			want:     []PasswordViolation{{Rule: PasswordRuleMinLength, Limit: 8}},
+		},
+		{
+			name:     "too long",
+			policy:   strict,
+			password: "Passw0rd!Passw0rd!",
+			want:     []PasswordViolation{{Rule: PasswordRuleMaxLength, Limit: 16}},
+		},
+		{
+			name:     "zero max length disables the upper bound",
+			policy:   PasswordPolicy{MinLength: 1},
+			password: "a very long passphrase that goes on and on",
+		},
+		{
+			name:     "length is counted in characters",
+			policy:   PasswordPolicy{MaxLength: 4, AllowUnicode: true},
+			password: "пароль",
+			want:     []PasswordViolation{{Rule: PasswordRuleMaxLength, Limit: 4}},
+		},
+		{
+			name:     "every class missing",
+			policy:   strict,
+			password: "        ",
+			want: []PasswordViolation{
+				{Rule: PasswordRuleUppercase},
+				{Rule: PasswordRuleLowercase},
+				{Rule: PasswordRuleDigit},
+			},
+		},
+		{
+			name:     "missing symbol",
+			policy:   strict,
+			password: "Passw0rdd",
+			want:     []PasswordViolation{{Rule: PasswordRuleSymbol}},
+		},
+		{
+			name:     "unicode rejected by default",
+			policy:   PasswordPolicy{},
+			password: "pässwörd",
+			want:     []PasswordViolation{{Rule: PasswordRuleInvalidCharacter, Characters: "äö"}},
+		},
+		{
+			name:     "unicode allowed",
+			policy:   PasswordPolicy{AllowUnicode: true, RequireUppercase: true},
+			password: "Pässwörd",
+		},
+		{
+			name:     "control characters are never allowed",
+			policy:   PasswordPolicy{AllowUnicode: true},
+			password: "pass\tword\n",
+			want:     []PasswordViolation{{Rule: PasswordRuleInvalidCharacter, Characters: "\t\n"}},
+		},
+		{
+			name:     "rejected characters are listed once",
+			policy:   PasswordPolicy{Symbols: "!"},
+			password: "a#b#c$",
+			want:     []PasswordViolation{{Rule: PasswordRuleInvalidCharacter, Characters: "#$"}},
+		},
+		{
+			name:     "restricted symbols",
+			policy:   PasswordPolicy{RequireSymbol: true, Symbols: "!@"},
+			password: "password#",
+			want: []PasswordViolation{
+				{Rule: PasswordRuleSymbol, Symbols: "!@"},
+				{Rule: PasswordRuleInvalidCharacter, Characters: "#"},
+			},
+		},
+		{
+			name:     "listed symbol accepted",
+			policy:   PasswordPolicy{RequireSymbol: true, Symbols: "!@"},
+			password: "password@",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Check(tt.password); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check(%q) = %+v, want %+v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
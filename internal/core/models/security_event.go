@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SecurityEventLoginSucceeded  = "login_succeeded"
+	SecurityEventLoginFailed     = "login_failed"
+	SecurityEventPasswordChanged = "password_changed"
+	SecurityEventSessionRevoked  = "session_revoked"
+	SecurityEventSessionsRevoked = "sessions_revoked"
+
+	SecurityEventAccountDeletionScheduled = "account_deletion_scheduled"
+	SecurityEventAccountRestored          = "account_restored"
+)
+
+const (
+	LoginMethodEmail    = "email"
+	LoginMethodUsername = "username"
+	LoginMethodGoogle   = "google"
+)
+
+// SecurityEvent is an entry of the account's security activity feed.
+type SecurityEvent struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Type      string    `json:"type"`
+	// Method is set for login events.
+	Method string `json:"method,omitempty"`
+	// Reason tells why a login failed or why sessions were revoked.
+	Reason    string    `json:"reason,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -7,12 +7,76 @@ import (
 )
 
 type Session struct {
-	ID        uuid.UUID `json:"id"`
-	AccountID uuid.UUID `json:"account_id"`
-	LastUsed  time.Time `json:"last_used"`
-	CreatedAt time.Time `json:"created_at"`
+	ID                   uuid.UUID  `json:"id"`
+	AccountID            uuid.UUID  `json:"account_id"`
+	ActiveOrganizationID *uuid.UUID `json:"active_organization_id,omitempty"`
+	IP                   string     `json:"ip,omitempty"`
+	UserAgent            string     `json:"user_agent,omitempty"`
+	OS                   string     `json:"os,omitempty"`
+	Browser              string     `json:"browser,omitempty"`
+	DeviceName           *string    `json:"device_name,omitempty"`
+	LastUsed             time.Time  `json:"last_used"`
+	CreatedAt            time.Time  `json:"created_at"`
 }
 
 func (s Session) IsNil() bool {
 	return s.ID == uuid.Nil
 }
+
+const (
+	SessionExpiredByIdle     = "idle"
+	SessionExpiredByAbsolute = "absolute"
+)
+
+// SessionLifetime limits how long a session may stay unused (Idle) and how
+// long it may live at all (Absolute). Zero disables the respective limit.
+type SessionLifetime struct {
+	Idle     time.Duration
+	Absolute time.Duration
+}
+
+func (l SessionLifetime) Enabled() bool {
+	return l.Idle > 0 || l.Absolute > 0
+}
+
+// ExpiryReason returns why the session is expired at the given moment,
+// or an empty string if it is still alive.
+func (l SessionLifetime) ExpiryReason(s Session, now time.Time) string {
+	if l.Absolute > 0 && !now.Before(s.CreatedAt.Add(l.Absolute)) {
+		return SessionExpiredByAbsolute
+	}
+	if l.Idle > 0 && !now.Before(s.LastUsed.Add(l.Idle)) {
+		return SessionExpiredByIdle
+	}
+
+	return ""
+}
+
+const (
+	SessionLimitReject = "reject"
+	SessionLimitEvict  = "evict"
+)
+
+// SessionLimit caps concurrent sessions of an account. When Max is reached
+// Policy decides whether a new login is refused or the least recently used
+// session is dropped to make room. Zero Max disables the limit.
+type SessionLimit struct {
+	Max    uint
+	Policy string
+}
+
+const (
+	SessionDeletedByLogout = "logout"
+	SessionDeletedByOwner  = "owner"
+
+	SessionsRevokedByOwner          = "owner"
+	SessionsRevokedByPasswordChange = "password_changed"
+	SessionsRevokedByAdmin          = "admin"
+	SessionsRevokedByStatusChange   = "status_changed"
+	SessionsRevokedByPasswordForce  = "password_change_required"
+	SessionsRevokedByDeletion       = "account_deletion"
+
+	SessionRevokedByIdleExpiry     = "expired_idle"
+	SessionRevokedByAbsoluteExpiry = "expired_absolute"
+	SessionRevokedByEviction       = "evicted_by_session_limit"
+)
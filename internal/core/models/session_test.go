@@ -0,0 +1,65 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessionLifetimeExpiryReason(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		lifetime SessionLifetime
+		session  Session
+		want     string
+	}{
+		{
+			name:     "no limits",
+			lifetime: SessionLifetime{},
+			session:  Session{CreatedAt: now.Add(-1000 * time.Hour), LastUsed: now.Add(-500 * time.Hour)},
+		},
+		{
+			name:     "alive",
+			lifetime: SessionLifetime{Idle: time.Hour, Absolute: 24 * time.Hour},
+			session:  Session{CreatedAt: now.Add(-2 * time.Hour), LastUsed: now.Add(-time.Minute)},
+		},
+		{
+			name:     "idle for too long",
+			lifetime: SessionLifetime{Idle: time.Hour, Absolute: 24 * time.Hour},
+			session:  Session{CreatedAt: now.Add(-2 * time.Hour), LastUsed: now.Add(-2 * time.Hour)},
+			want:     SessionExpiredByIdle,
+		},
+		{
+			name:     "idle exactly at the limit",
+			lifetime: SessionLifetime{Idle: time.Hour},
+			session:  Session{CreatedAt: now.Add(-time.Hour), LastUsed: now.Add(-time.Hour)},
+			want:     SessionExpiredByIdle,
+		},
+		{
+			name:     "lived for too long",
+			lifetime: SessionLifetime{Idle: time.Hour, Absolute: 24 * time.Hour},
+			session:  Session{CreatedAt: now.Add(-25 * time.Hour), LastUsed: now.Add(-time.Minute)},
+			want:     SessionExpiredByAbsolute,
+		},
+		{
+			name:     "absolute wins over idle",
+			lifetime: SessionLifetime{Idle: time.Hour, Absolute: 24 * time.Hour},
+			session:  Session{CreatedAt: now.Add(-25 * time.Hour), LastUsed: now.Add(-2 * time.Hour)},
+			want:     SessionExpiredByAbsolute,
+		},
+		{
+			name:     "idle limit disabled",
+			lifetime: SessionLifetime{Absolute: 24 * time.Hour},
+			session:  Session{CreatedAt: now.Add(-2 * time.Hour), LastUsed: now.Add(-2 * time.Hour)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.lifetime.ExpiryReason(tt.session, now); got != tt.want {
+				t.Errorf("ExpiryReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,4 +6,17 @@ type TokensPair struct {
 	SessionID uuid.UUID `json:"session_id"`
 	Refresh   string    `json:"refresh"`
 	Access    string    `json:"access"`
+
+	EvictedSessionIDs []uuid.UUID `json:"evicted_session_ids,omitempty"`
+
+	// PasswordChangeRequired marks a pair issued while an admin requires the
+	// password to be changed: Access only grants changing the password and
+	// Refresh is empty.
+	PasswordChangeRequired bool `json:"password_change_required,omitempty"`
+}
+
+// AccessOrgs is the organization context embedded into access tokens.
+type AccessOrgs struct {
+	ActiveOrganizationID *uuid.UUID
+	Memberships          []Member
 }
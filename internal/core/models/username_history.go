@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UsernameChange records a username the account gave up and when.
+type UsernameChange struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Username  string    `json:"username"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// UsernameLookup is the account a username resolves to. Previous is set when
+// the username is not the current one but was given up by the account.
+type UsernameLookup struct {
+	Account  Account
+	Previous *UsernameChange
+}
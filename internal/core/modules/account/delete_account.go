@@ -2,38 +2,185 @@ package account
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
 )
 
+type AccountDeletionConfig struct {
+	// GracePeriod is how long a deleted account can be restored by logging
+	// in, zero deletes accounts right away.
+	GracePeriod time.Duration
+
+	// MembershipsTimeout is how long to wait for the organization service to
+	// remove memberships of an account being deleted before asking again.
+	MembershipsTimeout time.Duration
+	// MaxAttempts is how many times to ask before the deletion is marked
+	// failed, zero asks until the memberships are gone.
+	MaxAttempts uint
+}
+
+// DeleteOwnAccount schedules deletion of the initiator's account. The account
+// becomes pending deletion, loses its sessions and is purged once the grace
+// period is over unless the owner logs in before.
 func (m Module) DeleteOwnAccount(ctx context.Context, initiator InitiatorData) error {
 	account, _, err := m.validateInitiatorSession(ctx, initiator)
 	if err != nil {
 		return err
 	}
 
-	exists, err := m.repo.ExistOrgMemberByAccount(ctx, initiator.AccountID)
-	if err != nil {
-		return err
-	}
-	if exists {
-		return errx.AccountHaveMembershipInOrg.Raise(
-			fmt.Errorf("account %s has a member of organizations", initiator.AccountID),
-		)
+	if m.deletion.GracePeriod <= 0 {
+		member, err := m.repo.ExistOrgMemberByAccount(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		if !member {
+			return m.deleteAccount(ctx, account.ID)
+		}
 	}
 
+	now := time.Now().UTC()
+
 	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
-		err = m.repo.DeleteAccount(ctx, initiator.AccountID)
+		account, err = m.repo.UpdateAccountStatus(txCtx, account.ID, UpdateAccountStatusParams{
+			Status: models.AccountStatusPendingDeletion,
+		})
+		if err != nil {
+			return err
+		}
+
+		deletion, err := m.repo.CreateAccountDeletion(txCtx, account.ID, now, now.Add(m.deletion.GracePeriod))
 		if err != nil {
 			return err
 		}
 
-		err = m.messenger.WriteAccountDeleted(ctx, account.ID)
+		err = m.revokeAccountSessions(txCtx, account.ID, models.SessionsRevokedByDeletion)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		_, err = m.repo.CreateSecurityEvent(txCtx, CreateSecurityEventParams{
+			AccountID: account.ID,
+			Type:      models.SecurityEventAccountDeletionScheduled,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = m.messenger.WriteAccountStatusUpdated(txCtx, account)
+		if err != nil {
+			return err
+		}
+
+		err = m.messenger.WriteAccountDeletionScheduled(txCtx, deletion)
+		if err != nil {
+			return err
+		}
+
+		if m.deletion.GracePeriod <= 0 {
+			_, err = m.advanceAccountDeletion(txCtx, deletion, now)
+		}
+
+		return err
+	})
+}
+
+// DeleteAccount removes any account right away on behalf of an administrator,
+// it is refused while the account is a member of organizations.
+func (m Module) DeleteAccount(ctx context.Context, accountID uuid.UUID) error {
+	account, err := m.repo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if err = m.checkAccountDeletable(ctx, account.ID); err != nil {
+		return err
+	}
+
+	return m.deleteAccount(ctx, account.ID)
+}
+
+func (m Module) checkAccountDeletable(ctx context.Context, accountID uuid.UUID) error {
+	exists, err := m.repo.ExistOrgMemberByAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errx.AccountHaveMembershipInOrg.Raise(
+			fmt.Errorf("account %s has a member of organizations", accountID),
+		)
+	}
+
+	return nil
+}
+
+func (m Module) deleteAccount(ctx context.Context, accountID uuid.UUID) error {
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		return m.purgeAccount(txCtx, accountID)
 	})
 }
+
+// restoreAccount cancels the pending deletion of the account when its grace
+// period is not over yet or the deletion failed, the account becomes active
+// again. A deletion waiting for memberships removal can't be taken back.
+// It has to run in the transaction signing the owner in, so the deletion is
+// cancelled only if the owner actually gets a session.
+func (m Module) restoreAccount(ctx context.Context, account models.Account) (models.Account, error) {
+	err := m.repo.LockAccount(ctx, account.ID)
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	deletion, err := m.repo.GetAccountDeletion(ctx, account.ID)
+	switch {
+	case errors.Is(err, errx.ErrorAccountDeletionNotFound):
+		// Restored by another login or an administrator meanwhile.
+		return m.repo.GetAccountByID(ctx, account.ID)
+	case err != nil:
+		return models.Account{}, err
+	case deletion.State == models.AccountDeletionAwaitingMemberships:
+		return models.Account{}, errx.ErrorAccountPendingDeletion.Raise(
+			fmt.Errorf("deletion of account %s is waiting for removal of its memberships", account.ID),
+		)
+	case deletion.State == models.AccountDeletionScheduled && !time.Now().UTC().Before(deletion.PurgeAfter):
+		return models.Account{}, errx.ErrorAccountPendingDeletion.Raise(
+			fmt.Errorf("grace period of account %s ended at %s", account.ID, deletion.PurgeAfter),
+		)
+	}
+
+	err = m.repo.DeleteAccountDeletion(ctx, account.ID)
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	account, err = m.repo.UpdateAccountStatus(ctx, account.ID, UpdateAccountStatusParams{
+		Status: models.AccountStatusActive,
+	})
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	_, err = m.repo.CreateSecurityEvent(ctx, CreateSecurityEventParams{
+		AccountID: account.ID,
+		Type:      models.SecurityEventAccountRestored,
+	})
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	err = m.messenger.WriteAccountStatusUpdated(ctx, account)
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	err = m.messenger.WriteAccountDeletionCancelled(ctx, account.ID)
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	return account, nil
+}
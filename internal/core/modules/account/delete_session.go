@@ -2,17 +2,15 @@ package account
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
 )
 
 func (m Module) Logout(ctx context.Context, initiator InitiatorData) error {
-	err := m.repo.DeleteAccountSession(ctx, initiator.AccountID, initiator.SessionID)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return m.deleteAccountSession(ctx, initiator.AccountID, initiator.SessionID, models.SessionDeletedByLogout)
 }
 
 func (m Module) DeleteOwnSession(ctx context.Context, initiator InitiatorData, sessionID uuid.UUID) error {
@@ -21,24 +19,58 @@ func (m Module) DeleteOwnSession(ctx context.Context, initiator InitiatorData, s
 		return err
 	}
 
-	err = m.repo.DeleteAccountSession(ctx, initiator.AccountID, sessionID)
+	return m.deleteAccountSession(ctx, initiator.AccountID, sessionID, models.SessionDeletedByOwner)
+}
+
+func (m Module) DeleteOwnSessions(ctx context.Context, initiator InitiatorData) error {
+	_, _, err := m.validateInitiatorSession(ctx, initiator)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		return m.revokeAccountSessions(txCtx, initiator.AccountID, models.SessionsRevokedByOwner)
+	})
 }
 
-func (m Module) DeleteOwnSessions(ctx context.Context, initiator InitiatorData) error {
-	_, _, err := m.validateInitiatorSession(ctx, initiator)
+// RevokeAccountSessions logs the account out everywhere on behalf of an administrator.
+func (m Module) RevokeAccountSessions(ctx context.Context, accountID uuid.UUID) error {
+	_, err := m.repo.GetAccountByID(ctx, accountID)
 	if err != nil {
 		return err
 	}
 
-	err = m.repo.DeleteSessionsForAccount(ctx, initiator.AccountID)
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		return m.revokeAccountSessions(txCtx, accountID, models.SessionsRevokedByAdmin)
+	})
+}
+
+func (m Module) deleteAccountSession(ctx context.Context, accountID, sessionID uuid.UUID, reason string) error {
+	session, err := m.repo.GetAccountSession(ctx, accountID, sessionID)
 	if err != nil {
 		return err
 	}
+	if session.IsNil() {
+		return errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("session with id %s not found for account %s", sessionID, accountID),
+		)
+	}
+
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err = m.repo.DeleteAccountSession(txCtx, accountID, sessionID)
+		if err != nil {
+			return err
+		}
+
+		err = m.messenger.WriteSessionDeleted(txCtx, session, reason)
+		if err != nil {
+			return err
+		}
+
+		if reason != models.SessionDeletedByOwner {
+			return nil
+		}
 
-	return nil
+		return m.recordSessionRevoked(txCtx, session, reason)
+	})
 }
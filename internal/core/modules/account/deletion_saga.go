@@ -0,0 +1,167 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+// PurgeDeletedAccounts moves on deletions whose grace period or wait for
+// memberships removal is over and returns how many accounts were deleted.
+// Every deletion is handled in its own transaction, one that fails is logged
+// and left for the next run so it can't hold back the others.
+func (m Module) PurgeDeletedAccounts(ctx context.Context, batchSize uint) (uint, error) {
+	var (
+		total   uint
+		skipped []uuid.UUID
+	)
+	for ctx.Err() == nil {
+		now := time.Now().UTC()
+
+		deletions, err := m.repo.GetDueAccountDeletions(ctx, now, batchSize, skipped...)
+		if err != nil {
+			return total, err
+		}
+
+		for _, deletion := range deletions {
+			deleted, err := m.moveOnAccountDeletion(ctx, deletion.AccountID, now)
+			if err != nil {
+				m.log.WithError(err).Errorf("failed to move on deletion of account %s", deletion.AccountID)
+				skipped = append(skipped, deletion.AccountID)
+				continue
+			}
+			if deleted {
+				total++
+			}
+		}
+
+		if len(deletions) == 0 || uint(len(deletions)) < batchSize {
+			break
+		}
+	}
+
+	return total, ctx.Err()
+}
+
+// moveOnAccountDeletion advances the deletion of the account if it is still
+// due once held, another run may have handled it in the meantime. The account
+// is locked before its deletion, as everywhere else.
+func (m Module) moveOnAccountDeletion(ctx context.Context, accountID uuid.UUID, now time.Time) (bool, error) {
+	var deleted bool
+	err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err := m.repo.LockAccount(txCtx, accountID)
+		if errors.Is(err, errx.ErrorAccountNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		deletion, err := m.repo.GetAccountDeletion(txCtx, accountID)
+		switch {
+		case errors.Is(err, errx.ErrorAccountDeletionNotFound):
+			return nil
+		case err != nil:
+			return err
+		case !deletion.Due(now):
+			return nil
+		}
+
+		deleted, err = m.advanceAccountDeletion(txCtx, deletion, now)
+		return err
+	})
+
+	return deleted, err
+}
+
+// ContinueAccountDeletion deletes the account once the organization service
+// removed the last of its memberships, it does nothing for accounts whose
+// deletion does not wait for that. Failed deletions are left for the owner to
+// restore or an administrator to delete.
+func (m Module) ContinueAccountDeletion(ctx context.Context, accountID uuid.UUID) error {
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err := m.repo.LockAccount(txCtx, accountID)
+		if errors.Is(err, errx.ErrorAccountNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		deletion, err := m.repo.GetAccountDeletion(txCtx, accountID)
+		switch {
+		case errors.Is(err, errx.ErrorAccountDeletionNotFound):
+			return nil
+		case err != nil:
+			return err
+		case deletion.State != models.AccountDeletionAwaitingMemberships:
+			return nil
+		}
+
+		member, err := m.repo.ExistOrgMemberByAccount(txCtx, accountID)
+		if err != nil || member {
+			return err
+		}
+
+		return m.purgeAccount(txCtx, accountID)
+	})
+}
+
+// advanceAccountDeletion deletes the account when it is not a member of any
+// organization. Otherwise it asks the organization service to remove the
+// memberships, again on every timeout until the attempts run out and the
+// deletion fails. It has to run in a transaction holding the deletion.
+// The account stays locked till the end of it, so no membership replicated
+// meanwhile can break the deletion of the account.
+func (m Module) advanceAccountDeletion(
+	ctx context.Context,
+	deletion models.AccountDeletion,
+	now time.Time,
+) (bool, error) {
+	err := m.repo.LockAccount(ctx, deletion.AccountID)
+	if err != nil {
+		return false, err
+	}
+
+	members, err := m.repo.GetOrgMembersByAccount(ctx, deletion.AccountID)
+	if err != nil {
+		return false, err
+	}
+	if len(members) == 0 {
+		return true, m.purgeAccount(ctx, deletion.AccountID)
+	}
+
+	if m.deletion.MaxAttempts > 0 && deletion.Attempts >= m.deletion.MaxAttempts {
+		deletion, err = m.repo.UpdateAccountDeletionState(
+			ctx, deletion.AccountID, models.AccountDeletionFailed, deletion.Attempts, nil,
+		)
+		if err != nil {
+			return false, err
+		}
+
+		return false, m.messenger.WriteAccountDeletionFailed(ctx, deletion)
+	}
+
+	timeoutAt := now.Add(m.deletion.MembershipsTimeout)
+	deletion, err = m.repo.UpdateAccountDeletionState(
+		ctx, deletion.AccountID, models.AccountDeletionAwaitingMemberships, deletion.Attempts+1, &timeoutAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return false, m.messenger.WriteAccountDeletionRequested(ctx, deletion, members)
+}
+
+func (m Module) purgeAccount(ctx context.Context, accountID uuid.UUID) error {
+	err := m.repo.DeleteAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	return m.messenger.WriteAccountDeleted(ctx, accountID)
+}
@@ -2,9 +2,11 @@ package account
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/restkit/pagi"
 )
 
 func (m Module) GetAccountByID(ctx context.Context, ID uuid.UUID) (models.Account, error) {
@@ -12,7 +14,7 @@ func (m Module) GetAccountByID(ctx context.Context, ID uuid.UUID) (models.Accoun
 }
 
 func (m Module) GetAccountByEmail(ctx context.Context, email string) (models.Account, error) {
-	return m.repo.GetAccountByEmail(ctx, email)
+	return m.repo.GetAccountByEmail(ctx, models.NormalizeEmail(email))
 }
 
 func (m Module) GetAccountByUsername(ctx context.Context, username string) (models.Account, error) {
@@ -22,3 +24,48 @@ func (m Module) GetAccountByUsername(ctx context.Context, username string) (mode
 func (m Module) GetAccountEmail(ctx context.Context, ID uuid.UUID) (models.AccountEmail, error) {
 	return m.repo.GetAccountEmail(ctx, ID)
 }
+
+type AccountsFilter struct {
+	Role           *string
+	Status         *string
+	UsernamePrefix *string
+	Email          *string
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+}
+
+func (m Module) FilterAccounts(
+	ctx context.Context,
+	filter AccountsFilter,
+	limit, offset uint,
+) (pagi.Page[[]models.Account], error) {
+	if filter.Email != nil {
+		email := models.NormalizeEmail(*filter.Email)
+		filter.Email = &email
+	}
+
+	return m.repo.FilterAccounts(ctx, filter, limit, offset)
+}
+
+func (m Module) GetAccountDetails(ctx context.Context, ID uuid.UUID) (models.AccountDetails, error) {
+	account, err := m.repo.GetAccountByID(ctx, ID)
+	if err != nil {
+		return models.AccountDetails{}, err
+	}
+
+	email, err := m.repo.GetAccountEmail(ctx, ID)
+	if err != nil {
+		return models.AccountDetails{}, err
+	}
+
+	sessions, err := m.repo.CountSessionsForAccount(ctx, ID)
+	if err != nil {
+		return models.AccountDetails{}, err
+	}
+
+	return models.AccountDetails{
+		Account:       account,
+		Email:         email,
+		SessionsCount: sessions,
+	}, nil
+}
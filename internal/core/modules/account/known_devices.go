@@ -0,0 +1,65 @@
+package account
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+type RememberKnownDeviceParams struct {
+	AccountID   uuid.UUID
+	Fingerprint string
+	IP          string
+	OS          string
+	Browser     string
+}
+
+// deviceFingerprint identifies the device and IP combination of the session.
+// Parsed OS and browser are used instead of the raw user agent so browser
+// updates do not look like a new device, the raw user agent is only the
+// fallback for clients we cannot recognize.
+func deviceFingerprint(session models.Session) string {
+	device := session.OS + "\x00" + session.Browser
+	if session.OS == "" && session.Browser == "" {
+		device = session.UserAgent
+	}
+
+	sum := sha256.Sum256([]byte(device + "\x00" + session.IP))
+	return hex.EncodeToString(sum[:])
+}
+
+// rememberDevice stores the device of the new session and, when the account
+// has signed in before but never from this device, asks for the owner to be
+// notified. It has to run inside the session creating transaction.
+func (m Module) rememberDevice(ctx context.Context, account models.Account, session models.Session) error {
+	known, err := m.repo.ExistsKnownDevicesForAccount(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	_, created, err := m.repo.RememberKnownDevice(ctx, RememberKnownDeviceParams{
+		AccountID:   account.ID,
+		Fingerprint: deviceFingerprint(session),
+		IP:          session.IP,
+		OS:          session.OS,
+		Browser:     session.Browser,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The very first device of an account is not news to its owner.
+	if !created || !known {
+		return nil
+	}
+
+	email, err := m.repo.GetAccountEmail(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	return m.messenger.WriteAccountLoginNewDevice(ctx, account, email, session)
+}
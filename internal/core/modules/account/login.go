@@ -2,46 +2,50 @@ package account
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
 )
 
-func (m Module) LoginByEmail(ctx context.Context, email, password string) (models.TokensPair, error) {
-	account, err := m.GetAccountByEmail(ctx, email)
-	if err != nil {
-		return models.TokensPair{}, err
-	}
-
-	err = m.checkAccountPassword(ctx, account.ID, password)
-	if err != nil {
-		return models.TokensPair{}, err
-	}
-
-	return m.createSession(ctx, account)
+func (m Module) LoginByEmail(
+	ctx context.Context,
+	email, password string,
+	client ClientData,
+) (models.TokensPair, error) {
+	return m.loginByPassword(ctx, models.LoginMethodEmail, func(ctx context.Context) (models.Account, error) {
+		return m.GetAccountByEmail(ctx, email)
+	}, password, client)
 }
 
-func (m Module) LoginByGoogle(ctx context.Context, email string) (models.TokensPair, error) {
+func (m Module) LoginByGoogle(ctx context.Context, email string, client ClientData) (models.TokensPair, error) {
 	account, err := m.GetAccountByEmail(ctx, email)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
 
-	return m.createSession(ctx, account)
-}
-
-func (m Module) LoginByUsername(ctx context.Context, username, password string) (models.TokensPair, error) {
-	account, err := m.GetAccountByUsername(ctx, username)
+	pair, err := m.createSession(ctx, account, models.LoginMethodGoogle, client)
 	if err != nil {
-		return models.TokensPair{}, err
-	}
+		if ferr := m.recordLoginFailure(ctx, account.ID, models.LoginMethodGoogle, client, err); ferr != nil {
+			return models.TokensPair{}, ferr
+		}
 
-	err = m.checkAccountPassword(ctx, account.ID, password)
-	if err != nil {
 		return models.TokensPair{}, err
 	}
 
-	return m.createSession(ctx, account)
+	return pair, nil
+}
+
+func (m Module) LoginByUsername(
+	ctx context.Context,
+	username, password string,
+	client ClientData,
+) (models.TokensPair, error) {
+	return m.loginByPassword(ctx, models.LoginMethodUsername, func(ctx context.Context) (models.Account, error) {
+		return m.GetAccountByUsername(ctx, username)
+	}, password, client)
 }
 
 func (m Module) checkAccountPassword(
@@ -54,46 +58,220 @@ func (m Module) checkAccountPassword(
 		return err
 	}
 
-	if err = passData.CheckPasswordMatch(password); err != nil {
-		return err
+	match, err := m.passwords.Verify(passData.Hash, password)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("verifying password hash of account %s, cause: %w", accountID, err),
+		)
+	}
+	if !match {
+		return errx.ErrorPasswordInvalid.Raise(
+			fmt.Errorf("invalid credentials for account %s", accountID),
+		)
 	}
 
 	return nil
 }
 
+// upgradePasswordHash rehashes a password that just passed the check when
+// its stored hash is outdated, the plain password is only known at login.
+// The upgrade is opportunistic, a failure is logged and leaves the old hash
+// for the next login instead of refusing this one.
+func (m Module) upgradePasswordHash(ctx context.Context, accountID uuid.UUID, password string) {
+	passData, err := m.repo.GetAccountPassword(ctx, accountID)
+	if err != nil {
+		m.log.WithError(err).Errorf("failed to get password of account %s for rehashing", accountID)
+		return
+	}
+	if !m.passwords.NeedsRehash(passData.Hash) {
+		return
+	}
+
+	hash, err := m.passwords.Hash(password)
+	if err != nil {
+		m.log.WithError(err).Errorf("failed to rehash password of account %s", accountID)
+		return
+	}
+
+	err = m.repo.RehashAccountPassword(ctx, accountID, passData.Hash, hash)
+	if err != nil {
+		m.log.WithError(err).Errorf("failed to store upgraded password hash of account %s", accountID)
+	}
+}
+
 func (m Module) createSession(
 	ctx context.Context,
 	account models.Account,
+	method string,
+	client ClientData,
 ) (models.TokensPair, error) {
+	var (
+		pair     models.TokensPair
+		passData models.AccountPassword
+		evicted  []uuid.UUID
+	)
 	sessionID := uuid.New()
 
-	pair, err := m.createTokensPair(sessionID, account)
+	err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		var err error
+
+		// Logging in is how the owner takes back the deletion of the account.
+		if account.Status == models.AccountStatusPendingDeletion {
+			account, err = m.restoreAccount(txCtx, account)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err = account.CanSignIn(time.Now().UTC()); err != nil {
+			return err
+		}
+
+		passData, err = m.repo.GetAccountPassword(txCtx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		pair, err = m.createTokensPair(txCtx, sessionID, account, nil)
+		if err != nil {
+			return err
+		}
+
+		// The session is created as usual so the password can be changed with it,
+		// but its refresh token is withheld and the access token only lets the
+		// owner change the password. The change revokes the session anyway.
+		if passData.MustChange {
+			pair.Access, err = m.jwt.GeneratePasswordChange(account, sessionID)
+			if err != nil {
+				return err
+			}
+		}
+
+		refreshHash, err := m.jwt.HashRefresh(pair.Refresh)
+		if err != nil {
+			return err
+		}
+
+		evicted, err = m.enforceSessionLimit(txCtx, account, sessionID)
+		if err != nil {
+			return err
+		}
+
+		session, err := m.repo.CreateSession(txCtx, client.sessionParams(sessionID, account.ID, refreshHash))
+		if err != nil {
+			return err
+		}
+
+		err = m.messenger.WriteSessionCreated(txCtx, session)
+		if err != nil {
+			return err
+		}
+
+		err = m.rememberDevice(txCtx, account, session)
+		if err != nil {
+			return err
+		}
+
+		err = m.clearLoginFailures(txCtx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		return m.recordLogin(txCtx, account.ID, method, client)
+	})
 	if err != nil {
 		return models.TokensPair{}, err
 	}
 
-	refreshHash, err := m.jwt.HashRefresh(pair.Refresh)
+	if passData.MustChange {
+		return models.TokensPair{
+			SessionID:              pair.SessionID,
+			Access:                 pair.Access,
+			EvictedSessionIDs:      evicted,
+			PasswordChangeRequired: true,
+		}, nil
+	}
+
+	return models.TokensPair{
+		SessionID:         pair.SessionID,
+		Refresh:           pair.Refresh,
+		Access:            pair.Access,
+		EvictedSessionIDs: evicted,
+	}, nil
+}
+
+// enforceSessionLimit makes room for one more session of the account according
+// to its role's limit and returns IDs of the sessions evicted for that.
+func (m Module) enforceSessionLimit(
+	ctx context.Context,
+	account models.Account,
+	newSessionID uuid.UUID,
+) ([]uuid.UUID, error) {
+	limit := m.sessions.limit(account.Role)
+	if limit.Max == 0 {
+		return nil, nil
+	}
+
+	err := m.repo.LockAccount(ctx, account.ID)
 	if err != nil {
-		return models.TokensPair{}, err
+		return nil, err
 	}
 
-	_, err = m.repo.CreateSession(ctx, sessionID, account.ID, refreshHash)
+	sessions, err := m.repo.GetSessionsForAccountByLastUsed(ctx, account.ID)
 	if err != nil {
-		return models.TokensPair{}, err
+		return nil, err
+	}
+	if uint(len(sessions)) < limit.Max {
+		return nil, nil
 	}
 
-	return models.TokensPair{
-		SessionID: pair.SessionID,
-		Refresh:   pair.Refresh,
-		Access:    pair.Access,
-	}, nil
+	if limit.Policy != models.SessionLimitEvict {
+		return nil, errx.ErrorSessionLimitReached.Raise(
+			fmt.Errorf("account %s already has %d of %d allowed sessions", account.ID, len(sessions), limit.Max),
+		)
+	}
+
+	excess := sessions[:uint(len(sessions))-limit.Max+1]
+	evicted := make([]uuid.UUID, 0, len(excess))
+	for _, session := range excess {
+		evicted = append(evicted, session.ID)
+	}
+
+	err = m.repo.DeleteSessions(ctx, evicted...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range excess {
+		err = m.messenger.WriteSessionEvicted(ctx, session, newSessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordSessionRevoked(ctx, session, models.SessionRevokedByEviction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return evicted, nil
 }
 
 func (m Module) createTokensPair(
+	ctx context.Context,
 	sessionID uuid.UUID,
 	account models.Account,
+	activeOrganizationID *uuid.UUID,
 ) (models.TokensPair, error) {
-	access, err := m.jwt.GenerateAccess(account, sessionID)
+	memberships, err := m.repo.GetOrgMembersByAccount(ctx, account.ID)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	access, err := m.jwt.GenerateAccess(account, sessionID, models.AccessOrgs{
+		ActiveOrganizationID: activeOrganizationID,
+		Memberships:          memberships,
+	})
 	if err != nil {
 		return models.TokensPair{}, err
 	}
@@ -0,0 +1,249 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+type LoginProtectionConfig struct {
+	Account models.LockoutPolicy
+	IP      models.LockoutPolicy
+}
+
+// LoginLockedError is returned while logins for an account or a client IP are
+// locked after too many failures, it unwraps to errx.ErrorAccountTemporarilyLocked.
+type LoginLockedError struct {
+	Until time.Time
+	cause error
+}
+
+func (e *LoginLockedError) Error() string {
+	return e.cause.Error()
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return e.cause
+}
+
+// RetryAfter is how long the client has to wait before the next attempt.
+func (e *LoginLockedError) RetryAfter(now time.Time) time.Duration {
+	if d := e.Until.Sub(now); d > 0 {
+		return d
+	}
+
+	return 0
+}
+
+func (m Module) loginPolicy(scope string) models.LockoutPolicy {
+	if scope == models.LoginFailureScopeIP {
+		return m.loginProtection.IP
+	}
+
+	return m.loginProtection.Account
+}
+
+func (m Module) checkLoginLocked(ctx context.Context, scope, key string) error {
+	if key == "" || !m.loginPolicy(scope).Enabled() {
+		return nil
+	}
+
+	failures, err := m.repo.GetLoginFailures(ctx, scope, key)
+	if err != nil {
+		return err
+	}
+	if !failures.Locked(time.Now().UTC()) {
+		return nil
+	}
+
+	return &LoginLockedError{
+		Until: *failures.LockedUntil,
+		cause: errx.ErrorAccountTemporarilyLocked.Raise(
+			fmt.Errorf("login for %s %s is locked until %s", scope, key, failures.LockedUntil),
+		),
+	}
+}
+
+func (m Module) registerLoginFailure(ctx context.Context, scope, key string) error {
+	policy := m.loginPolicy(scope)
+	if key == "" || !policy.Enabled() {
+		return nil
+	}
+
+	err := m.repo.LockLoginFailures(ctx, scope, key)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	failures, err := m.repo.RegisterLoginFailure(ctx, scope, key, now, policy.WindowStart(now))
+	if err != nil {
+		return err
+	}
+
+	lockout := policy.Lockout(failures.Failures)
+	if lockout == 0 {
+		return nil
+	}
+
+	return m.repo.LockLogin(ctx, scope, key, now.Add(lockout))
+}
+
+// registerLoginFailures counts a failed attempt against the client IP and,
+// unless it is empty, the account. Each key is held from counting the failure
+// to locking it out until the transaction ends, so concurrent failures can't
+// skip a lockout step.
+func (m Module) registerLoginFailures(ctx context.Context, ip, accountKey string) error {
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err := m.registerLoginFailure(txCtx, models.LoginFailureScopeIP, ip)
+		if err != nil {
+			return err
+		}
+
+		return m.registerLoginFailure(txCtx, models.LoginFailureScopeAccount, accountKey)
+	})
+}
+
+// loginByPassword checks the password of the account returned by getAccount
+// and counts failures of the account and of the client IP, refusing logins
+// while either of them is locked.
+func (m Module) loginByPassword(
+	ctx context.Context,
+	method string,
+	getAccount func(ctx context.Context) (models.Account, error),
+	password string,
+	client ClientData,
+) (models.TokensPair, error) {
+	account, err := m.checkLoginAttempt(ctx, getAccount, password, client)
+	if err == nil {
+		m.upgradePasswordHash(ctx, account.ID, password)
+
+		var pair models.TokensPair
+		pair, err = m.createSession(ctx, account, method, client)
+		if err == nil {
+			return pair, nil
+		}
+	}
+
+	if account.ID != uuid.Nil {
+		if ferr := m.recordLoginFailure(ctx, account.ID, method, client, err); ferr != nil {
+			return models.TokensPair{}, ferr
+		}
+	}
+
+	return models.TokensPair{}, err
+}
+
+// checkLoginAttempt checks lockouts of the client IP and of the account and
+// then the password. Failure counters are locked only while they are updated,
+// not while the password hash is verified, so a slow hash doesn't hold up
+// other attempts from the same IP.
+func (m Module) checkLoginAttempt(
+	ctx context.Context,
+	getAccount func(ctx context.Context) (models.Account, error),
+	password string,
+	client ClientData,
+) (models.Account, error) {
+	err := m.checkLoginLocked(ctx, models.LoginFailureScopeIP, client.IP)
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	account, err := getAccount(ctx)
+	if errors.Is(err, errx.ErrorAccountNotFound) {
+		if ferr := m.registerLoginFailures(ctx, client.IP, ""); ferr != nil {
+			return models.Account{}, ferr
+		}
+	}
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	accountKey := account.ID.String()
+	err = m.checkLoginLocked(ctx, models.LoginFailureScopeAccount, accountKey)
+	if err != nil {
+		return account, err
+	}
+
+	err = m.checkAccountPassword(ctx, account.ID, password)
+	if errors.Is(err, errx.ErrorPasswordInvalid) {
+		if ferr := m.registerLoginFailures(ctx, client.IP, accountKey); ferr != nil {
+			return models.Account{}, ferr
+		}
+	}
+	if err != nil {
+		return account, err
+	}
+
+	// Guesses verified concurrently with the one that locked the IP or the
+	// account out are refused as well, even if they are right.
+	err = m.checkLoginLocked(ctx, models.LoginFailureScopeIP, client.IP)
+	if err != nil {
+		return account, err
+	}
+
+	return account, m.checkLoginLocked(ctx, models.LoginFailureScopeAccount, accountKey)
+}
+
+// clearLoginFailures resets failed logins of the account once it signed in,
+// it runs in the transaction creating the session so a refused session
+// doesn't count as a successful login.
+func (m Module) clearLoginFailures(ctx context.Context, accountID uuid.UUID) error {
+	if !m.loginProtection.Account.Enabled() {
+		return nil
+	}
+
+	return m.repo.DeleteLoginFailures(ctx, models.LoginFailureScopeAccount, accountID.String())
+}
+
+// ClearAccountLoginLockout resets failed logins of the account on behalf of
+// an administrator, lifting its lockout if any. Lockouts of client IPs that
+// recently failed to log in to the account are lifted as well.
+func (m Module) ClearAccountLoginLockout(ctx context.Context, accountID uuid.UUID) error {
+	_, err := m.repo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err = m.repo.DeleteLoginFailures(txCtx, models.LoginFailureScopeAccount, accountID.String())
+		if err != nil {
+			return err
+		}
+
+		window := m.loginProtection.IP.Window
+		if !m.loginProtection.IP.Enabled() || window <= 0 {
+			return nil
+		}
+
+		ips, err := m.repo.GetLoginFailureIPs(txCtx, accountID, time.Now().UTC().Add(-window))
+		if err != nil {
+			return err
+		}
+
+		for _, ip := range ips {
+			err = m.repo.DeleteLoginFailures(txCtx, models.LoginFailureScopeIP, ip)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// PruneLoginFailures drops failure counters that can not lock anything
+// anymore and returns how many were dropped.
+func (m Module) PruneLoginFailures(ctx context.Context) (uint, error) {
+	window := max(m.loginProtection.Account.Window, m.loginProtection.IP.Window)
+	if window <= 0 {
+		return 0, nil
+	}
+
+	return m.repo.DeleteStaleLoginFailures(ctx, time.Now().UTC().Add(-window))
+}
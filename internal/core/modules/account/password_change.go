@@ -0,0 +1,37 @@
+package account
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+// RequireAccountPasswordChange makes the owner change the password on the
+// next login, until then logins only get a token good for changing it. All
+// sessions of the account are revoked so the requirement applies at once.
+func (m Module) RequireAccountPasswordChange(ctx context.Context, accountID uuid.UUID) error {
+	_, err := m.repo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		_, err = m.repo.SetAccountPasswordMustChange(txCtx, accountID, true)
+		if err != nil {
+			return err
+		}
+
+		return m.revokeAccountSessions(txCtx, accountID, models.SessionsRevokedByPasswordForce)
+	})
+}
+
+func (m Module) CancelAccountPasswordChange(ctx context.Context, accountID uuid.UUID) error {
+	_, err := m.repo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.repo.SetAccountPasswordMustChange(ctx, accountID, false)
+	return err
+}
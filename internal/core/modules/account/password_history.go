@@ -0,0 +1,63 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+// checkPasswordReuse refuses a new password matching the current one or one
+// of the previous ones kept in the account's password history.
+func (m Module) checkPasswordReuse(
+	ctx context.Context,
+	accountID uuid.UUID,
+	currentPassword, newPassword string,
+) error {
+	history := m.passwordPolicy.History
+	if history == 0 {
+		return nil
+	}
+
+	reused := newPassword == currentPassword
+	if !reused {
+		hashes, err := m.repo.GetPasswordHistory(ctx, accountID, history-1)
+		if err != nil {
+			return err
+		}
+
+		for _, hash := range hashes {
+			reused, err = m.passwords.Verify(hash, newPassword)
+			if err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("verifying password history of account %s, cause: %w", accountID, err),
+				)
+			}
+			if reused {
+				break
+			}
+		}
+	}
+
+	if !reused {
+		return nil
+	}
+
+	return newPasswordPolicyError([]models.PasswordViolation{{
+		Rule:  models.PasswordRuleRecentlyUsed,
+		Limit: history,
+	}})
+}
+
+// rememberPassword puts the replaced password hash into the history, it has
+// to run inside the transaction changing the password.
+func (m Module) rememberPassword(ctx context.Context, accountID uuid.UUID, replacedHash string) error {
+	var keep uint
+	if m.passwordPolicy.History > 0 {
+		keep = m.passwordPolicy.History - 1
+	}
+
+	return m.repo.AddPasswordHistory(ctx, accountID, replacedHash, keep)
+}
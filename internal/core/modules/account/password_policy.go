@@ -0,0 +1,89 @@
+package account
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+type PasswordPolicyConfig struct {
+	// Policy applies to every role without its own entry in RolePolicies.
+	Policy       models.PasswordPolicy
+	RolePolicies map[string]models.PasswordPolicy
+
+	// History is how many of the latest passwords of an account, the current
+	// one included, can not be set again. Zero allows any reuse.
+	History uint
+
+	// ChangeCooldown applies to every role without its own entry in
+	// RoleChangeCooldowns, zero allows changing the password at any time.
+	ChangeCooldown      time.Duration
+	RoleChangeCooldowns map[string]time.Duration
+}
+
+func (c PasswordPolicyConfig) policy(role string) models.PasswordPolicy {
+	if p, ok := c.RolePolicies[role]; ok {
+		return p
+	}
+
+	return c.Policy
+}
+
+func (c PasswordPolicyConfig) changeCooldown(role string) time.Duration {
+	if d, ok := c.RoleChangeCooldowns[role]; ok {
+		return d
+	}
+
+	return c.ChangeCooldown
+}
+
+// PasswordPolicyError lists every rule a rejected password breaks, it unwraps
+// to errx.ErrorPasswordIsNotAllowed.
+type PasswordPolicyError struct {
+	Violations []models.PasswordViolation
+	cause      error
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return e.cause.Error()
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return e.cause
+}
+
+func (m Module) checkPasswordRequirements(role, password string) error {
+	violations := m.passwordPolicy.policy(role).Check(password)
+
+	breached, err := m.breached.IsBreached(password)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("checking password against breached passwords, cause: %w", err),
+		)
+	}
+	if breached {
+		violations = append(violations, models.PasswordViolation{Rule: models.PasswordRuleBreached})
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return newPasswordPolicyError(violations)
+}
+
+func newPasswordPolicyError(violations []models.PasswordViolation) error {
+	rules := make([]string, 0, len(violations))
+	for _, v := range violations {
+		rules = append(rules, v.Rule)
+	}
+
+	return &PasswordPolicyError{
+		Violations: violations,
+		cause: errx.ErrorPasswordIsNotAllowed.Raise(
+			fmt.Errorf("password breaks policy rules %v", rules),
+		),
+	}
+}
@@ -3,12 +3,13 @@ package account
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
 )
 
-func (m Module) Refresh(ctx context.Context, oldRefreshToken string) (models.TokensPair, error) {
+func (m Module) Refresh(ctx context.Context, oldRefreshToken string, client ClientData) (models.TokensPair, error) {
 	tokenData, err := m.jwt.ParseRefreshClaims(oldRefreshToken)
 	if err != nil {
 		return models.TokensPair{}, err
@@ -19,48 +20,80 @@ func (m Module) Refresh(ctx context.Context, oldRefreshToken string) (models.Tok
 		return models.TokensPair{}, err
 	}
 
+	session, err := m.repo.GetSession(ctx, tokenData.SessionID)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
 	token, err := m.repo.GetSessionToken(ctx, tokenData.SessionID)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
 
-	refreshHash, err := m.jwt.HashRefresh(token)
+	oldRefreshHash, err := m.jwt.HashRefresh(oldRefreshToken)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
 
-	if refreshHash != oldRefreshToken {
+	if oldRefreshHash != token {
 		return models.TokensPair{}, errx.ErrorSessionTokenMismatch.Raise(
 			fmt.Errorf(
-				"refresh token does not match for session %s and account %s, cause: %w",
-				tokenData.SessionID, tokenData.AccountID, err,
+				"refresh token does not match for session %s and account %s",
+				tokenData.SessionID, tokenData.AccountID,
 			),
 		)
 	}
 
-	refresh, err := m.jwt.GenerateRefresh(account, tokenData.SessionID)
-	if err != nil {
+	if err = account.CanSignIn(time.Now().UTC()); err != nil {
 		return models.TokensPair{}, err
 	}
 
-	refreshNewHash, err := m.jwt.HashRefresh(refresh)
+	passData, err := m.repo.GetAccountPassword(ctx, account.ID)
 	if err != nil {
 		return models.TokensPair{}, err
 	}
+	if passData.MustChange {
+		return models.TokensPair{}, errx.ErrorPasswordChangeRequired.Raise(
+			fmt.Errorf("account %s has to change the password before refreshing sessions", account.ID),
+		)
+	}
 
-	access, err := m.jwt.GenerateAccess(account, tokenData.SessionID)
-	if err != nil {
-		return models.TokensPair{}, err
+	reason := m.sessions.lifetime(account.Role).ExpiryReason(session, time.Now().UTC())
+	if reason != "" {
+		if err = m.expireSession(ctx, session, reason); err != nil {
+			return models.TokensPair{}, err
+		}
+
+		return models.TokensPair{}, errx.ErrorSessionExpired.Raise(
+			fmt.Errorf("session %s of account %s expired by %s lifetime", session.ID, account.ID, reason),
+		)
 	}
 
-	_, err = m.repo.UpdateSessionToken(ctx, tokenData.SessionID, refreshNewHash)
+	// The scope check and the token rotation share a transaction so a session
+	// never gets tokens for an organization it was just dropped from.
+	var pair models.TokensPair
+	err = m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		activeOrganizationID, err := m.checkActiveOrganization(txCtx, session)
+		if err != nil {
+			return err
+		}
+
+		pair, err = m.createTokensPair(txCtx, tokenData.SessionID, account, activeOrganizationID)
+		if err != nil {
+			return err
+		}
+
+		refreshNewHash, err := m.jwt.HashRefresh(pair.Refresh)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.repo.RefreshSession(txCtx, tokenData.SessionID, oldRefreshHash, refreshNewHash, client.IP)
+		return err
+	})
 	if err != nil {
 		return models.TokensPair{}, err
 	}
 
-	return models.TokensPair{
-		SessionID: tokenData.SessionID,
-		Refresh:   refresh,
-		Access:    access,
-	}, nil
+	return pair, nil
 }
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
 	"github.com/netbill/restkit/tokens/roles"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type RegistrationParams struct {
@@ -21,6 +21,8 @@ func (m Module) Registration(
 	ctx context.Context,
 	params RegistrationParams,
 ) (models.Account, error) {
+	params.Email = models.NormalizeEmail(params.Email)
+
 	check, err := m.repo.ExistsAccountByEmail(ctx, params.Email)
 	if err != nil {
 		return models.Account{}, err
@@ -31,43 +33,37 @@ func (m Module) Registration(
 		)
 	}
 
-	check, err = m.repo.ExistsAccountByUsername(ctx, params.Username)
-	if err != nil {
-		return models.Account{}, err
-	}
-	if check {
-		return models.Account{}, errx.ErrorUsernameAlreadyTaken.Raise(
-			fmt.Errorf("account with username %s already exists", params.Username),
-		)
-	}
-
 	err = roles.ValidateUserSystemRole(params.Role)
 	if err != nil {
 		return models.Account{}, err
 	}
 
-	err = m.checkPasswordRequirements(params.Password)
+	err = m.checkPasswordRequirements(params.Role, params.Password)
 	if err != nil {
 		return models.Account{}, err
 	}
 
-	err = m.checkUsernameRequirements(ctx, params.Username)
+	username := normalizeUsername(params.Username)
+	skeleton, err := m.checkUsernameRequirements(ctx, uuid.Nil, username)
 	if err != nil {
 		return models.Account{}, err
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(params.Password), bcrypt.DefaultCost)
+	hash, err := m.passwords.Hash(params.Password)
 	if err != nil {
-		return models.Account{}, err
+		return models.Account{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("hashing password for new account, cause: %w", err),
+		)
 	}
 
 	var account models.Account
 	err = m.repo.Transaction(ctx, func(ctx context.Context) error {
 		account, err = m.repo.CreateAccount(ctx, CreateAccountParams{
-			Role:         params.Role,
-			Username:     params.Username,
-			Email:        params.Email,
-			PasswordHash: string(hash),
+			Role:             params.Role,
+			Username:         username,
+			UsernameSkeleton: skeleton,
+			Email:            params.Email,
+			PasswordHash:     hash,
 		})
 		if err != nil {
 			return err
@@ -0,0 +1,156 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/restkit/pagi"
+)
+
+type SecurityEventsConfig struct {
+	// Retention is how long events are kept, zero keeps them forever.
+	Retention time.Duration
+}
+
+type CreateSecurityEventParams struct {
+	AccountID uuid.UUID
+	Type      string
+	Method    string
+	Reason    string
+	IP        string
+	UserAgent string
+}
+
+func (m Module) GetOwnSecurityEvents(
+	ctx context.Context,
+	initiator InitiatorData,
+	limit, offset uint,
+) (pagi.Page[[]models.SecurityEvent], error) {
+	_, _, err := m.validateInitiatorSession(ctx, initiator)
+	if err != nil {
+		return pagi.Page[[]models.SecurityEvent]{}, err
+	}
+
+	return m.repo.GetSecurityEventsForAccount(ctx, initiator.AccountID, limit, offset)
+}
+
+// PruneSecurityEvents drops events older than the retention period and
+// returns how many were dropped.
+func (m Module) PruneSecurityEvents(ctx context.Context, batchSize uint) (uint, error) {
+	if m.securityEvents.Retention <= 0 {
+		return 0, nil
+	}
+
+	before := time.Now().UTC().Add(-m.securityEvents.Retention)
+
+	var total uint
+	for ctx.Err() == nil {
+		n, err := m.repo.DeleteSecurityEventsBefore(ctx, before, batchSize)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n == 0 || n < batchSize {
+			break
+		}
+	}
+
+	return total, ctx.Err()
+}
+
+func (m Module) recordLogin(ctx context.Context, accountID uuid.UUID, method string, client ClientData) error {
+	_, err := m.repo.CreateSecurityEvent(ctx, CreateSecurityEventParams{
+		AccountID: accountID,
+		Type:      models.SecurityEventLoginSucceeded,
+		Method:    method,
+		IP:        client.IP,
+		UserAgent: client.userAgent(),
+	})
+
+	return err
+}
+
+// recordLoginFailure stores a refused login of an existing account, errors
+// not caused by the client are not recorded.
+func (m Module) recordLoginFailure(
+	ctx context.Context,
+	accountID uuid.UUID,
+	method string,
+	client ClientData,
+	cause error,
+) error {
+	reason := loginFailureReason(cause)
+	if reason == "" {
+		return nil
+	}
+
+	_, err := m.repo.CreateSecurityEvent(ctx, CreateSecurityEventParams{
+		AccountID: accountID,
+		Type:      models.SecurityEventLoginFailed,
+		Method:    method,
+		Reason:    reason,
+		IP:        client.IP,
+		UserAgent: client.userAgent(),
+	})
+
+	return err
+}
+
+func loginFailureReason(err error) string {
+	switch {
+	case errors.Is(err, errx.ErrorPasswordInvalid):
+		return "password_invalid"
+	case errors.Is(err, errx.ErrorAccountTemporarilyLocked):
+		return "temporarily_locked"
+	case errors.Is(err, errx.ErrorAccountSuspended):
+		return models.AccountStatusSuspended
+	case errors.Is(err, errx.ErrorAccountBanned):
+		return models.AccountStatusBanned
+	case errors.Is(err, errx.ErrorAccountPendingDeletion):
+		return models.AccountStatusPendingDeletion
+	case errors.Is(err, errx.ErrorSessionLimitReached):
+		return "session_limit_reached"
+	default:
+		return ""
+	}
+}
+
+// recordSessionRevoked adds the session_revoked event of a single session to
+// the feed of its account.
+func (m Module) recordSessionRevoked(ctx context.Context, session models.Session, reason string) error {
+	_, err := m.repo.CreateSecurityEvent(ctx, CreateSecurityEventParams{
+		AccountID: session.AccountID,
+		Type:      models.SecurityEventSessionRevoked,
+		Reason:    reason,
+		IP:        session.IP,
+		UserAgent: session.UserAgent,
+	})
+
+	return err
+}
+
+// revokeAccountSessions deletes all sessions of the account, it has to run
+// inside a transaction.
+func (m Module) revokeAccountSessions(ctx context.Context, accountID uuid.UUID, reason string) error {
+	err := m.repo.DeleteSessionsForAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	err = m.messenger.WriteAccountSessionsRevoked(ctx, accountID, reason)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.repo.CreateSecurityEvent(ctx, CreateSecurityEventParams{
+		AccountID: accountID,
+		Type:      models.SecurityEventSessionsRevoked,
+		Reason:    reason,
+	})
+
+	return err
+}
@@ -4,31 +4,66 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
-	"unicode"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/logium"
 	"github.com/netbill/restkit/pagi"
 	"github.com/netbill/restkit/tokens"
 )
 
 type Module struct {
+	log       *logium.Logger
 	repo      repo
 	jwt       JWTManager
+	passwords PasswordHasher
+	breached  BreachedPasswords
+	usernames Confusables
 	messenger messenger
+
+	sessions        SessionsConfig
+	loginProtection LoginProtectionConfig
+	securityEvents  SecurityEventsConfig
+	passwordPolicy  PasswordPolicyConfig
+	usernamePolicy  UsernamePolicyConfig
+	deletion        AccountDeletionConfig
+}
+
+type Config struct {
+	Sessions        SessionsConfig
+	LoginProtection LoginProtectionConfig
+	SecurityEvents  SecurityEventsConfig
+	PasswordPolicy  PasswordPolicyConfig
+	UsernamePolicy  UsernamePolicyConfig
+	Deletion        AccountDeletionConfig
 }
 
 func NewService(
+	log *logium.Logger,
 	db repo,
 	jwt JWTManager,
+	passwords PasswordHasher,
+	breached BreachedPasswords,
+	usernames Confusables,
 	event messenger,
+	cfg Config,
 ) *Module {
 	return &Module{
-		repo:      db,
-		jwt:       jwt,
-		messenger: event,
+		log:             log,
+		repo:            db,
+		jwt:             jwt,
+		passwords:       passwords,
+		breached:        breached,
+		usernames:       usernames,
+		messenger:       event,
+		sessions:        cfg.Sessions,
+		loginProtection: cfg.LoginProtection,
+		securityEvents:  cfg.SecurityEvents,
+		passwordPolicy:  cfg.PasswordPolicy,
+		usernamePolicy:  cfg.UsernamePolicy,
+		deletion:        cfg.Deletion,
 	}
 }
 
@@ -39,25 +74,85 @@ type JWTManager interface {
 	HashRefresh(rawRefresh string) (string, error)
 
 	GenerateAccess(
-		account models.Account, sessionID uuid.UUID,
+		account models.Account, sessionID uuid.UUID, orgs models.AccessOrgs,
 	) (string, error)
 
 	GenerateRefresh(
 		account models.Account, sessionID uuid.UUID,
 	) (string, error)
+
+	// GeneratePasswordChange issues an access token accepted only for
+	// changing the password.
+	GeneratePasswordChange(
+		account models.Account, sessionID uuid.UUID,
+	) (string, error)
+}
+
+// PasswordHasher produces self-describing password hashes, so hashes made
+// with older algorithms or parameters keep verifying after a change.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) (bool, error)
+	NeedsRehash(hash string) bool
+}
+
+// BreachedPasswords knows passwords exposed in data breaches.
+type BreachedPasswords interface {
+	IsBreached(password string) (bool, error)
+}
+
+// Confusables maps strings to their UTS #39 skeletons, strings that look
+// alike share a skeleton.
+type Confusables interface {
+	Skeleton(s string) string
 }
 
 type messenger interface {
 	WriteAccountCreated(ctx context.Context, account models.Account) error
-	WriteAccountUsernameUpdated(ctx context.Context, account models.Account) error
+	WriteAccountUsernameUpdated(ctx context.Context, account models.Account, oldUsername string) error
+	WriteAccountPasswordUpdated(ctx context.Context, account models.Account) error
+	WriteAccountRoleUpdated(ctx context.Context, account models.Account) error
+	WriteAccountStatusUpdated(ctx context.Context, account models.Account) error
+	WriteAccountDeletionScheduled(ctx context.Context, deletion models.AccountDeletion) error
+	WriteAccountDeletionCancelled(ctx context.Context, accountID uuid.UUID) error
+	WriteAccountDeletionRequested(
+		ctx context.Context,
+		deletion models.AccountDeletion,
+		members []models.Member,
+	) error
+	WriteAccountDeletionFailed(ctx context.Context, deletion models.AccountDeletion) error
 	WriteAccountDeleted(ctx context.Context, accountID uuid.UUID) error
+	WriteAccountLoginNewDevice(
+		ctx context.Context,
+		account models.Account,
+		email models.AccountEmail,
+		session models.Session,
+	) error
+
+	WriteSessionCreated(ctx context.Context, session models.Session) error
+	WriteSessionDeleted(ctx context.Context, session models.Session, reason string) error
+	WriteSessionExpired(ctx context.Context, session models.Session, reason string) error
+	WriteSessionEvicted(ctx context.Context, session models.Session, bySessionID uuid.UUID) error
+	WriteAccountSessionsRevoked(ctx context.Context, accountID uuid.UUID, reason string) error
 }
 
 type CreateAccountParams struct {
-	Role         string
-	Email        string
-	Username     string
-	PasswordHash string
+	Role             string
+	Email            string
+	Username         string
+	UsernameSkeleton string
+	PasswordHash     string
+}
+
+type CreateSessionParams struct {
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	HashToken  string
+	IP         string
+	UserAgent  string
+	OS         string
+	Browser    string
+	DeviceName *string
 }
 
 type repo interface {
@@ -67,12 +162,37 @@ type repo interface {
 	) (models.Account, error)
 
 	GetAccountByID(ctx context.Context, accountID uuid.UUID) (models.Account, error)
+	LockAccount(ctx context.Context, accountID uuid.UUID) error
 	GetAccountByEmail(ctx context.Context, email string) (models.Account, error)
 	GetAccountByUsername(ctx context.Context, username string) (models.Account, error)
 
+	FilterAccounts(
+		ctx context.Context,
+		filter AccountsFilter,
+		limit, offset uint,
+	) (pagi.Page[[]models.Account], error)
+
 	ExistsAccountByID(ctx context.Context, accountID uuid.UUID) (bool, error)
 	ExistsAccountByEmail(ctx context.Context, email string) (bool, error)
-	ExistsAccountByUsername(ctx context.Context, username string) (bool, error)
+	GetAccountByUsernameSkeleton(ctx context.Context, skeleton string) (models.Account, error)
+	GetAccountByUsernameFold(ctx context.Context, username string) (models.Account, error)
+	GetAccountsWithoutUsernameSkeleton(ctx context.Context, after uuid.UUID, limit uint) ([]models.Account, error)
+	SetAccountUsernameSkeleton(ctx context.Context, accountID uuid.UUID, skeleton string) error
+
+	AddUsernameHistory(
+		ctx context.Context,
+		accountID uuid.UUID,
+		username, skeleton string,
+		changedAt time.Time,
+	) (models.UsernameChange, error)
+	GetLastUsernameChange(ctx context.Context, accountID uuid.UUID) (models.UsernameChange, error)
+	GetLastUsernameChangeByUsername(ctx context.Context, username string) (models.UsernameChange, error)
+	ExistsUsernameReleasedSince(
+		ctx context.Context,
+		skeleton string,
+		exceptAccountID uuid.UUID,
+		since time.Time,
+	) (bool, error)
 
 	GetAccountEmail(ctx context.Context, accountID uuid.UUID) (models.AccountEmail, error)
 	GetAccountPassword(ctx context.Context, accountID uuid.UUID) (models.AccountPassword, error)
@@ -81,16 +201,66 @@ type repo interface {
 		ctx context.Context,
 		accountID uuid.UUID,
 		passwordHash string,
+		change string,
+	) (models.AccountPassword, error)
+	SetAccountPasswordMustChange(
+		ctx context.Context,
+		accountID uuid.UUID,
+		mustChange bool,
 	) (models.AccountPassword, error)
+	RehashAccountPassword(
+		ctx context.Context,
+		accountID uuid.UUID,
+		oldHash, newHash string,
+	) error
+	GetPasswordHistory(ctx context.Context, accountID uuid.UUID, limit uint) ([]string, error)
+	AddPasswordHistory(ctx context.Context, accountID uuid.UUID, hash string, keep uint) error
 	UpdateAccountUsername(
 		ctx context.Context,
 		accountID uuid.UUID,
-		newUsername string,
+		newUsername, skeleton string,
+	) (models.Account, error)
+	UpdateAccountRole(
+		ctx context.Context,
+		accountID uuid.UUID,
+		role string,
+	) (models.Account, error)
+	UpdateAccountStatus(
+		ctx context.Context,
+		accountID uuid.UUID,
+		params UpdateAccountStatusParams,
 	) (models.Account, error)
+	ResetExpiredAccountStatuses(
+		ctx context.Context,
+		before time.Time,
+		limit uint,
+	) ([]models.Account, error)
+	TouchAccount(ctx context.Context, accountID uuid.UUID) (models.Account, error)
 
 	DeleteAccount(ctx context.Context, accountID uuid.UUID) error
 
-	CreateSession(ctx context.Context, sessionID, accountID uuid.UUID, hashToken string) (models.Session, error)
+	CreateAccountDeletion(
+		ctx context.Context,
+		accountID uuid.UUID,
+		requestedAt, purgeAfter time.Time,
+	) (models.AccountDeletion, error)
+	GetAccountDeletion(ctx context.Context, accountID uuid.UUID) (models.AccountDeletion, error)
+	GetDueAccountDeletions(
+		ctx context.Context,
+		before time.Time,
+		limit uint,
+		skip ...uuid.UUID,
+	) ([]models.AccountDeletion, error)
+	UpdateAccountDeletionState(
+		ctx context.Context,
+		accountID uuid.UUID,
+		state string,
+		attempts uint,
+		timeoutAt *time.Time,
+	) (models.AccountDeletion, error)
+	DeleteAccountDeletion(ctx context.Context, accountID uuid.UUID) error
+
+	CreateSession(ctx context.Context, params CreateSessionParams) (models.Session, error)
 	GetSession(ctx context.Context, sessionID uuid.UUID) (models.Session, error)
 	GetAccountSession(
 		ctx context.Context,
@@ -101,6 +271,8 @@ type repo interface {
 		accountID uuid.UUID,
 		limit, offset uint,
 	) (pagi.Page[[]models.Session], error)
+	CountSessionsForAccount(ctx context.Context, accountID uuid.UUID) (uint, error)
+	GetSessionsForAccountByLastUsed(ctx context.Context, accountID uuid.UUID) ([]models.Session, error)
 	GetSessionToken(ctx context.Context, sessionID uuid.UUID) (string, error)
 	UpdateSessionToken(
 		ctx context.Context,
@@ -108,90 +280,66 @@ type repo interface {
 		token string,
 	) (models.Session, error)
 
-	DeleteSession(ctx context.Context, sessionID uuid.UUID) error
-	DeleteSessionsForAccount(ctx context.Context, accountID uuid.UUID) error
-	DeleteAccountSession(ctx context.Context, accountID, sessionID uuid.UUID) error
-
-	ExistOrgMemberByAccount(ctx context.Context, accountID uuid.UUID) (bool, error)
-
-	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
-}
-
-func (m Module) checkUsernameRequirements(ctx context.Context, username string) error {
-	_, err := m.repo.GetAccountByUsername(ctx, username)
-	if err != nil {
-		return err
-	}
+	RefreshSession(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		oldToken, token, ip string,
+	) (models.Session, error)
+	UpdateAccountSessionDeviceName(
+		ctx context.Context,
+		accountID, sessionID uuid.UUID,
+		name *string,
+	) (models.Session, error)
 
-	if len(username) < 3 || len(username) > 32 {
-		return errx.ErrorUsernameIsNotAllowed.Raise(
-			fmt.Errorf("username must be between 3 and 32 characters"),
-		)
-	}
+	UpdateSessionsLastUsed(ctx context.Context, lastUsed map[uuid.UUID]time.Time) error
 
-	for _, r := range username {
-		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
-			return errx.ErrorUsernameIsNotAllowed.Raise(
-				fmt.Errorf("username contains invalid characters %s", string(r)),
-			)
-		}
-	}
+	UpdateSessionActiveOrganization(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		organizationID *uuid.UUID,
+	) (models.Session, error)
 
-	return nil
-}
+	DeleteSession(ctx context.Context, sessionID uuid.UUID) error
+	DeleteSessions(ctx context.Context, sessionIDs ...uuid.UUID) error
+	DeleteExpiredSessions(
+		ctx context.Context,
+		filter ExpiredSessionsFilter,
+		limit uint,
+	) ([]models.Session, error)
+	DeleteSessionsForAccount(ctx context.Context, accountID uuid.UUID) error
+	DeleteAccountSession(ctx context.Context, accountID, sessionID uuid.UUID) error
 
-func (m Module) checkPasswordRequirements(password string) error {
-	if len(password) < 8 || len(password) > 32 {
-		return errx.ErrorPasswordIsNotAllowed.Raise(
-			fmt.Errorf("password must be between 8 and 32 characters"),
-		)
-	}
+	LockLoginFailures(ctx context.Context, scope, key string) error
+	GetLoginFailures(ctx context.Context, scope, key string) (models.LoginFailures, error)
+	RegisterLoginFailure(
+		ctx context.Context,
+		scope, key string,
+		at, windowStart time.Time,
+	) (models.LoginFailures, error)
+	LockLogin(ctx context.Context, scope, key string, until time.Time) error
+	DeleteLoginFailures(ctx context.Context, scope, key string) error
+	DeleteStaleLoginFailures(ctx context.Context, before time.Time) (uint, error)
+
+	CreateSecurityEvent(ctx context.Context, params CreateSecurityEventParams) (models.SecurityEvent, error)
+	GetSecurityEventsForAccount(
+		ctx context.Context,
+		accountID uuid.UUID,
+		limit, offset uint,
+	) (pagi.Page[[]models.SecurityEvent], error)
+	DeleteSecurityEventsBefore(ctx context.Context, before time.Time, limit uint) (uint, error)
+	GetLoginFailureIPs(ctx context.Context, accountID uuid.UUID, since time.Time) ([]string, error)
 
-	var (
-		hasUpper, hasLower, hasDigit, hasSpecial bool
-	)
-
-	allowedSpecials := "-.!#$%&?,@"
-
-	for _, r := range password {
-		switch {
-		case unicode.IsUpper(r):
-			hasUpper = true
-		case unicode.IsLower(r):
-			hasLower = true
-		case unicode.IsDigit(r):
-			hasDigit = true
-		case strings.ContainsRune(allowedSpecials, r):
-			hasSpecial = true
-		default:
-			return errx.ErrorPasswordIsNotAllowed.Raise(
-				fmt.Errorf("password contains invalid characters %s", string(r)),
-			)
-		}
-	}
+	RememberKnownDevice(
+		ctx context.Context,
+		params RememberKnownDeviceParams,
+	) (models.KnownDevice, bool, error)
+	ExistsKnownDevicesForAccount(ctx context.Context, accountID uuid.UUID) (bool, error)
 
-	if !hasUpper {
-		return errx.ErrorPasswordIsNotAllowed.Raise(
-			fmt.Errorf("need at least one uppercase letter"),
-		)
-	}
-	if !hasLower {
-		return errx.ErrorPasswordIsNotAllowed.Raise(
-			fmt.Errorf("need at least one lower case letter"),
-		)
-	}
-	if !hasDigit {
-		return errx.ErrorPasswordIsNotAllowed.Raise(
-			fmt.Errorf("need at least one digit"),
-		)
-	}
-	if !hasSpecial {
-		return errx.ErrorPasswordIsNotAllowed.Raise(
-			fmt.Errorf("need at least one special character from %s", allowedSpecials),
-		)
-	}
+	ExistOrgMemberByAccount(ctx context.Context, accountID uuid.UUID) (bool, error)
+	ExistOrgMember(ctx context.Context, accountID, organizationID uuid.UUID) (bool, error)
+	GetOrgMembersByAccount(ctx context.Context, accountID uuid.UUID) ([]models.Member, error)
 
-	return nil
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type InitiatorData struct {
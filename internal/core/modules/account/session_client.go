@@ -0,0 +1,106 @@
+package account
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const maxUserAgentLength = 512
+
+type ClientData struct {
+	IP         string
+	UserAgent  string
+	DeviceName *string
+}
+
+type userAgentRule struct {
+	marker string
+	name   string
+}
+
+// Order matters: most user agents mention several platforms and engines,
+// so more specific markers have to be checked before generic ones.
+var (
+	osRules = []userAgentRule{
+		{marker: "Windows", name: "Windows"},
+		{marker: "iPhone", name: "iOS"},
+		{marker: "iPad", name: "iPadOS"},
+		{marker: "iPod", name: "iOS"},
+		{marker: "Android", name: "Android"},
+		{marker: "CrOS", name: "ChromeOS"},
+		{marker: "Macintosh", name: "macOS"},
+		{marker: "Mac OS X", name: "macOS"},
+		{marker: "Linux", name: "Linux"},
+	}
+
+	browserRules = []userAgentRule{
+		{marker: "Edg/", name: "Edge"},
+		{marker: "EdgA/", name: "Edge"},
+		{marker: "EdgiOS/", name: "Edge"},
+		{marker: "OPR/", name: "Opera"},
+		{marker: "Opera", name: "Opera"},
+		{marker: "YaBrowser/", name: "Yandex Browser"},
+		{marker: "SamsungBrowser/", name: "Samsung Internet"},
+		{marker: "Firefox/", name: "Firefox"},
+		{marker: "FxiOS/", name: "Firefox"},
+		{marker: "CriOS/", name: "Chrome"},
+		{marker: "Chrome/", name: "Chrome"},
+		{marker: "Safari/", name: "Safari"},
+		{marker: "curl/", name: "curl"},
+		{marker: "PostmanRuntime/", name: "Postman"},
+		{marker: "okhttp/", name: "OkHttp"},
+	}
+)
+
+func parseUserAgent(userAgent string) (os, browser string) {
+	return matchUserAgent(userAgent, osRules), matchUserAgent(userAgent, browserRules)
+}
+
+func matchUserAgent(userAgent string, rules []userAgentRule) string {
+	for _, rule := range rules {
+		if strings.Contains(userAgent, rule.marker) {
+			return rule.name
+		}
+	}
+
+	return ""
+}
+
+// userAgent is the client's user agent cut to the length stored in the database.
+func (c ClientData) userAgent() string {
+	if len(c.UserAgent) > maxUserAgentLength {
+		return strings.ToValidUTF8(c.UserAgent[:maxUserAgentLength], "")
+	}
+
+	return c.UserAgent
+}
+
+func (c ClientData) sessionParams(sessionID, accountID uuid.UUID, hashToken string) CreateSessionParams {
+	userAgent := c.userAgent()
+	os, browser := parseUserAgent(userAgent)
+
+	return CreateSessionParams{
+		ID:         sessionID,
+		AccountID:  accountID,
+		HashToken:  hashToken,
+		IP:         c.IP,
+		UserAgent:  userAgent,
+		OS:         os,
+		Browser:    browser,
+		DeviceName: normalizeDeviceName(c.DeviceName),
+	}
+}
+
+func normalizeDeviceName(name *string) *string {
+	if name == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*name)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
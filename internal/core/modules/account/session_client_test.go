@@ -0,0 +1,91 @@
+package account
+
+import "testing"
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		os        string
+		browser   string
+	}{
+		{
+			name:      "chrome on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			os:        "Windows",
+			browser:   "Chrome",
+		},
+		{
+			name:      "edge on windows",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.0.0",
+			os:        "Windows",
+			browser:   "Edge",
+		},
+		{
+			name:      "opera on linux",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 OPR/106.0.0.0",
+			os:        "Linux",
+			browser:   "Opera",
+		},
+		{
+			name:      "safari on macos",
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			os:        "macOS",
+			browser:   "Safari",
+		},
+		{
+			name:      "firefox on linux",
+			userAgent: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			os:        "Linux",
+			browser:   "Firefox",
+		},
+		{
+			name:      "chrome on iphone",
+			userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1",
+			os:        "iOS",
+			browser:   "Chrome",
+		},
+		{
+			name:      "safari on ipad",
+			userAgent: "Mozilla/5.0 (iPad; CPU OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+			os:        "iPadOS",
+			browser:   "Safari",
+		},
+		{
+			name:      "samsung internet on android",
+			userAgent: "Mozilla/5.0 (Linux; Android 13; SM-S901B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+			os:        "Android",
+			browser:   "Samsung Internet",
+		},
+		{
+			name:      "chrome on chromeos",
+			userAgent: "Mozilla/5.0 (X11; CrOS x86_64 14541.0.0) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			os:        "ChromeOS",
+			browser:   "Chrome",
+		},
+		{
+			name:      "curl",
+			userAgent: "curl/8.4.0",
+			browser:   "curl",
+		},
+		{
+			name:      "unknown",
+			userAgent: "some-client/1.0",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os, browser := parseUserAgent(tt.userAgent)
+			if os != tt.os {
+				t.Errorf("os = %q, want %q", os, tt.os)
+			}
+			if browser != tt.browser {
+				t.Errorf("browser = %q, want %q", browser, tt.browser)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+type SessionsConfig struct {
+	// Lifetime applies to every role without its own entry in RoleLifetimes.
+	Lifetime      models.SessionLifetime
+	RoleLifetimes map[string]models.SessionLifetime
+
+	// Limit applies to every role without its own entry in RoleLimits.
+	Limit      models.SessionLimit
+	RoleLimits map[string]models.SessionLimit
+}
+
+func (c SessionsConfig) limit(role string) models.SessionLimit {
+	if l, ok := c.RoleLimits[role]; ok {
+		return l
+	}
+
+	return c.Limit
+}
+
+func (c SessionsConfig) lifetime(role string) models.SessionLifetime {
+	if l, ok := c.RoleLifetimes[role]; ok {
+		return l
+	}
+
+	return c.Lifetime
+}
+
+type ExpiredSessionsFilter struct {
+	Roles        []string
+	ExcludeRoles bool
+
+	IdleBefore    *time.Time
+	CreatedBefore *time.Time
+}
+
+func expiredSessionsFilter(lifetime models.SessionLifetime, now time.Time) ExpiredSessionsFilter {
+	var filter ExpiredSessionsFilter
+	if lifetime.Idle > 0 {
+		idleBefore := now.Add(-lifetime.Idle)
+		filter.IdleBefore = &idleBefore
+	}
+	if lifetime.Absolute > 0 {
+		createdBefore := now.Add(-lifetime.Absolute)
+		filter.CreatedBefore = &createdBefore
+	}
+
+	return filter
+}
+
+// ReapExpiredSessions deletes sessions that outlived their role's lifetime
+// policy, batchSize rows per transaction, and reports how many were removed.
+func (m Module) ReapExpiredSessions(ctx context.Context, batchSize uint) (uint, error) {
+	now := time.Now().UTC()
+
+	var total uint
+	roles := make([]string, 0, len(m.sessions.RoleLifetimes))
+	for role, lifetime := range m.sessions.RoleLifetimes {
+		roles = append(roles, role)
+		if !lifetime.Enabled() {
+			continue
+		}
+
+		filter := expiredSessionsFilter(lifetime, now)
+		filter.Roles = []string{role}
+
+		n, err := m.reapExpiredSessions(ctx, lifetime, filter, now, batchSize)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+
+	if !m.sessions.Lifetime.Enabled() {
+		return total, nil
+	}
+
+	filter := expiredSessionsFilter(m.sessions.Lifetime, now)
+	filter.Roles = roles
+	filter.ExcludeRoles = true
+
+	n, err := m.reapExpiredSessions(ctx, m.sessions.Lifetime, filter, now, batchSize)
+	return total + n, err
+}
+
+func (m Module) reapExpiredSessions(
+	ctx context.Context,
+	lifetime models.SessionLifetime,
+	filter ExpiredSessionsFilter,
+	now time.Time,
+	batchSize uint,
+) (uint, error) {
+	var total uint
+	for ctx.Err() == nil {
+		var sessions []models.Session
+		err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+			var err error
+			sessions, err = m.repo.DeleteExpiredSessions(txCtx, filter, batchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, session := range sessions {
+				reason := lifetime.ExpiryReason(session, now)
+
+				err = m.messenger.WriteSessionExpired(txCtx, session, reason)
+				if err != nil {
+					return err
+				}
+
+				err = m.recordSessionRevoked(txCtx, session, expiryRevokeReason(reason))
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += uint(len(sessions))
+		if len(sessions) == 0 || uint(len(sessions)) < batchSize {
+			break
+		}
+	}
+
+	return total, ctx.Err()
+}
+
+func (m Module) expireSession(ctx context.Context, session models.Session, reason string) error {
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err := m.repo.DeleteSession(txCtx, session.ID)
+		if err != nil {
+			return err
+		}
+
+		err = m.messenger.WriteSessionExpired(txCtx, session, reason)
+		if err != nil {
+			return err
+		}
+
+		return m.recordSessionRevoked(txCtx, session, expiryRevokeReason(reason))
+	})
+}
+
+// expiryRevokeReason is the security event reason for a session expired by
+// the given lifetime limit.
+func expiryRevokeReason(reason string) string {
+	if reason == models.SessionExpiredByAbsolute {
+		return models.SessionRevokedByAbsoluteExpiry
+	}
+
+	return models.SessionRevokedByIdleExpiry
+}
@@ -0,0 +1,90 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+// SwitchActiveOrganization scopes the initiator session to the given organization
+// and reissues its tokens. A nil organizationID removes the scope.
+func (m Module) SwitchActiveOrganization(
+	ctx context.Context,
+	initiator InitiatorData,
+	organizationID *uuid.UUID,
+) (models.TokensPair, error) {
+	account, _, err := m.validateInitiatorSession(ctx, initiator)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	if organizationID != nil {
+		exists, err := m.repo.ExistOrgMember(ctx, account.ID, *organizationID)
+		if err != nil {
+			return models.TokensPair{}, err
+		}
+		if !exists {
+			return models.TokensPair{}, errx.ErrorOrgMemberNotFound.Raise(
+				fmt.Errorf("account %s is not a member of organization %s", account.ID, *organizationID),
+			)
+		}
+	}
+
+	pair, err := m.createTokensPair(ctx, initiator.SessionID, account, organizationID)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	refreshHash, err := m.jwt.HashRefresh(pair.Refresh)
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	err = m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		_, err = m.repo.UpdateSessionActiveOrganization(txCtx, initiator.SessionID, organizationID)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.repo.UpdateSessionToken(txCtx, initiator.SessionID, refreshHash)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.TokensPair{}, err
+	}
+
+	return pair, nil
+}
+
+// checkActiveOrganization drops the session scope once the account
+// is no longer a member of the organization it points to.
+func (m Module) checkActiveOrganization(
+	ctx context.Context,
+	session models.Session,
+) (*uuid.UUID, error) {
+	if session.ActiveOrganizationID == nil {
+		return nil, nil
+	}
+
+	exists, err := m.repo.ExistOrgMember(ctx, session.AccountID, *session.ActiveOrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return session.ActiveOrganizationID, nil
+	}
+
+	_, err = m.repo.UpdateSessionActiveOrganization(ctx, session.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
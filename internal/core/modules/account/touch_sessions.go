@@ -0,0 +1,12 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (m Module) TouchSessions(ctx context.Context, lastUsed map[uuid.UUID]time.Time) error {
+	return m.repo.UpdateSessionsLastUsed(ctx, lastUsed)
+}
@@ -3,9 +3,10 @@ package account
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/netbill/auth-svc/internal/core/errx"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/netbill/auth-svc/internal/core/models"
 )
 
 func (m Module) UpdatePassword(
@@ -23,7 +24,8 @@ func (m Module) UpdatePassword(
 		return err
 	}
 
-	if err = passData.CanChangePassword(); err != nil {
+	err = passData.CanChangePassword(m.passwordPolicy.changeCooldown(account.Role), time.Now().UTC())
+	if err != nil {
 		return err
 	}
 
@@ -31,29 +33,55 @@ func (m Module) UpdatePassword(
 		return err
 	}
 
-	if err = m.checkPasswordRequirements(newPassword); err != nil {
+	if err = m.checkPasswordRequirements(account.Role, newPassword); err != nil {
 		return err
 	}
 
-	//TODO remove from here
-	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err = m.checkPasswordReuse(ctx, account.ID, oldPassword, newPassword); err != nil {
+		return err
+	}
+
+	hash, err := m.passwords.Hash(newPassword)
 	if err != nil {
 		return errx.ErrorInternal.Raise(
-			fmt.Errorf("hashing new newPassword for account '%s', cause: %w", initiator.AccountID, err),
+			fmt.Errorf("hashing new password for account '%s', cause: %w", initiator.AccountID, err),
 		)
 	}
 
 	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
-		_, err = m.repo.UpdateAccountPassword(ctx, initiator.AccountID, string(hash))
+		change := models.PasswordChangeOwner
+		if passData.MustChange {
+			change = models.PasswordChangeForced
+		}
+
+		_, err = m.repo.UpdateAccountPassword(txCtx, initiator.AccountID, hash, change)
+		if err != nil {
+			return err
+		}
+
+		err = m.rememberPassword(txCtx, account.ID, passData.Hash)
+		if err != nil {
+			return err
+		}
+
+		account, err = m.repo.TouchAccount(txCtx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		err = m.messenger.WriteAccountPasswordUpdated(txCtx, account)
 		if err != nil {
 			return err
 		}
 
-		err = m.repo.DeleteSessionsForAccount(ctx, account.ID)
+		_, err = m.repo.CreateSecurityEvent(txCtx, CreateSecurityEventParams{
+			AccountID: account.ID,
+			Type:      models.SecurityEventPasswordChanged,
+		})
 		if err != nil {
 			return err
 		}
 
-		return nil
+		return m.revokeAccountSessions(txCtx, account.ID, models.SessionsRevokedByPasswordChange)
 	})
 }
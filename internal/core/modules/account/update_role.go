@@ -0,0 +1,42 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/restkit/tokens/roles"
+)
+
+func (m Module) UpdateAccountRole(ctx context.Context, accountID uuid.UUID, role string) (models.Account, error) {
+	if err := roles.ValidateUserSystemRole(role); err != nil {
+		return models.Account{}, errx.ErrorRoleNotSupported.Raise(
+			fmt.Errorf("role %s is not supported, cause: %w", role, err),
+		)
+	}
+
+	account, err := m.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	if account.Role == role {
+		return account, nil
+	}
+
+	err = m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		account, err = m.repo.UpdateAccountRole(txCtx, accountID, role)
+		if err != nil {
+			return err
+		}
+
+		return m.messenger.WriteAccountRoleUpdated(txCtx, account)
+	})
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	return account, nil
+}
@@ -0,0 +1,29 @@
+package account
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+func (m Module) UpdateOwnSessionDeviceName(
+	ctx context.Context,
+	initiator InitiatorData,
+	sessionID uuid.UUID,
+	deviceName *string,
+) (models.Session, error) {
+	_, _, err := m.validateInitiatorSession(ctx, initiator)
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	session, err := m.repo.UpdateAccountSessionDeviceName(
+		ctx, initiator.AccountID, sessionID, normalizeDeviceName(deviceName),
+	)
+	if err != nil {
+		return models.Session{}, err
+	}
+
+	return session, nil
+}
@@ -0,0 +1,136 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+type UpdateAccountStatusParams struct {
+	Status string
+	Reason *string
+	// Until makes a suspension or ban temporary, the account becomes active
+	// again once it passes.
+	Until *time.Time
+}
+
+// UpdateAccountStatus changes the status of the account on behalf of an
+// administrator, sessions of the account are revoked when it stops being active.
+// A pending deletion of the account is cancelled, unless it is waiting for
+// removal of the memberships, the organization service may be removing them
+// already.
+func (m Module) UpdateAccountStatus(
+	ctx context.Context,
+	accountID uuid.UUID,
+	params UpdateAccountStatusParams,
+) (models.Account, error) {
+	switch params.Status {
+	case models.AccountStatusActive:
+		params.Reason = nil
+		params.Until = nil
+	case models.AccountStatusSuspended, models.AccountStatusBanned:
+		if params.Until != nil && !params.Until.After(time.Now().UTC()) {
+			return models.Account{}, errx.ErrorAccountStatusUntilInvalid.Raise(
+				fmt.Errorf("status until %s is not in the future", params.Until),
+			)
+		}
+	default:
+		return models.Account{}, errx.ErrorAccountStatusNotSupported.Raise(
+			fmt.Errorf("status %s can not be set to account", params.Status),
+		)
+	}
+
+	var account models.Account
+	err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err := m.repo.LockAccount(txCtx, accountID)
+		if err != nil {
+			return err
+		}
+
+		pendingDeletion := true
+		deletion, err := m.repo.GetAccountDeletion(txCtx, accountID)
+		switch {
+		case errors.Is(err, errx.ErrorAccountDeletionNotFound):
+			pendingDeletion = false
+		case err != nil:
+			return err
+		case deletion.State == models.AccountDeletionAwaitingMemberships:
+			return errx.ErrorAccountPendingDeletion.Raise(
+				fmt.Errorf("deletion of account %s is waiting for removal of its memberships", accountID),
+			)
+		}
+
+		account, err = m.repo.UpdateAccountStatus(txCtx, accountID, params)
+		if err != nil {
+			return err
+		}
+
+		// Any status set by an administrator takes the account out of its
+		// pending deletion.
+		if pendingDeletion {
+			err = m.repo.DeleteAccountDeletion(txCtx, accountID)
+			if err != nil {
+				return err
+			}
+
+			err = m.messenger.WriteAccountDeletionCancelled(txCtx, accountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if account.Status != models.AccountStatusActive {
+			err = m.revokeAccountSessions(txCtx, accountID, models.SessionsRevokedByStatusChange)
+			if err != nil {
+				return err
+			}
+		}
+
+		return m.messenger.WriteAccountStatusUpdated(txCtx, account)
+	})
+	if err != nil {
+		return models.Account{}, err
+	}
+
+	return account, nil
+}
+
+// ReapExpiredAccountStatuses makes active again the accounts whose temporary
+// suspension or ban is over and returns how many were restored.
+func (m Module) ReapExpiredAccountStatuses(ctx context.Context, batchSize uint) (uint, error) {
+	var total uint
+	for ctx.Err() == nil {
+		var accounts []models.Account
+		err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+			var err error
+			accounts, err = m.repo.ResetExpiredAccountStatuses(txCtx, time.Now().UTC(), batchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, account := range accounts {
+				err = m.messenger.WriteAccountStatusUpdated(txCtx, account)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += uint(len(accounts))
+		if len(accounts) == 0 || uint(len(accounts)) < batchSize {
+			break
+		}
+	}
+
+	return total, ctx.Err()
+}
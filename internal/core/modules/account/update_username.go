@@ -2,7 +2,11 @@ package account
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
 )
 
@@ -12,17 +16,34 @@ func (m Module) UpdateUsername(ctx context.Context, initiator InitiatorData, new
 		return models.Account{}, err
 	}
 
-	if err = m.checkUsernameRequirements(ctx, newUsername); err != nil {
+	newUsername = normalizeUsername(newUsername)
+	if newUsername == account.Username {
+		return account, nil
+	}
+
+	now := time.Now().UTC()
+	if err = m.checkUsernameChangeCooldown(ctx, account, now); err != nil {
+		return models.Account{}, err
+	}
+
+	skeleton, err := m.checkUsernameRequirements(ctx, initiator.AccountID, newUsername)
+	if err != nil {
 		return models.Account{}, err
 	}
 
+	oldUsername := account.Username
 	err = m.repo.Transaction(ctx, func(txCtx context.Context) error {
-		account, err = m.repo.UpdateAccountUsername(ctx, initiator.AccountID, newUsername)
+		_, err = m.repo.AddUsernameHistory(txCtx, account.ID, oldUsername, m.usernameSkeleton(oldUsername), now)
+		if err != nil {
+			return err
+		}
+
+		account, err = m.repo.UpdateAccountUsername(txCtx, initiator.AccountID, newUsername, skeleton)
 		if err != nil {
 			return err
 		}
 
-		if err = m.messenger.WriteAccountUsernameUpdated(ctx, account); err != nil {
+		if err = m.messenger.WriteAccountUsernameUpdated(txCtx, account, oldUsername); err != nil {
 			return err
 		}
 
@@ -34,3 +55,58 @@ func (m Module) UpdateUsername(ctx context.Context, initiator InitiatorData, new
 
 	return account, nil
 }
+
+func (m Module) checkUsernameChangeCooldown(ctx context.Context, account models.Account, now time.Time) error {
+	cooldown := m.usernamePolicy.changeCooldown(account.Role)
+	if cooldown <= 0 {
+		return nil
+	}
+
+	last, err := m.repo.GetLastUsernameChange(ctx, account.ID)
+	switch {
+	case errors.Is(err, errx.ErrorUsernameChangeNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if now.Sub(last.ChangedAt) >= cooldown {
+		return nil
+	}
+
+	return errx.ErrorCannotChangeUsernameYet.Raise(
+		fmt.Errorf("account with id %s cannot change username until %s", account.ID, last.ChangedAt.Add(cooldown)),
+	)
+}
+
+// LookupUsername resolves a username to the account holding it or, when
+// nobody does, to the account that gave it up last, so handles stored
+// before a rename keep working.
+func (m Module) LookupUsername(ctx context.Context, username string) (models.UsernameLookup, error) {
+	username = normalizeUsername(username)
+
+	account, err := m.repo.GetAccountByUsername(ctx, username)
+	switch {
+	case err == nil:
+		return models.UsernameLookup{Account: account}, nil
+	case !errors.Is(err, errx.ErrorAccountNotFound):
+		return models.UsernameLookup{}, err
+	}
+
+	change, err := m.repo.GetLastUsernameChangeByUsername(ctx, username)
+	switch {
+	case errors.Is(err, errx.ErrorUsernameChangeNotFound):
+		return models.UsernameLookup{}, errx.ErrorAccountNotFound.Raise(
+			fmt.Errorf("no account has or had username %s", username),
+		)
+	case err != nil:
+		return models.UsernameLookup{}, err
+	}
+
+	account, err = m.repo.GetAccountByID(ctx, change.AccountID)
+	if err != nil {
+		return models.UsernameLookup{}, err
+	}
+
+	return models.UsernameLookup{Account: account, Previous: &change}, nil
+}
@@ -0,0 +1,163 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+type UsernamePolicyConfig struct {
+	// Reserved usernames can't be taken by anyone, neither can names that
+	// look like one of them.
+	Reserved []string
+
+	// Quarantine keeps a given up username, and names that look like it,
+	// from being taken by another account for this long.
+	Quarantine time.Duration
+
+	// ChangeCooldown applies to every role without its own entry in
+	// RoleChangeCooldowns.
+	ChangeCooldown      time.Duration
+	RoleChangeCooldowns map[string]time.Duration
+}
+
+func (c UsernamePolicyConfig) changeCooldown(role string) time.Duration {
+	if d, ok := c.RoleChangeCooldowns[role]; ok {
+		return d
+	}
+
+	return c.ChangeCooldown
+}
+
+// normalizeUsername brings compatibility forms, like fullwidth letters or
+// ligatures, to the plain characters they stand for.
+func normalizeUsername(username string) string {
+	return norm.NFKC.String(username)
+}
+
+// usernameSkeleton is equal for usernames that differ only in case or in
+// characters that look alike. Case is folded on both sides since
+// prototypes are not necessarily lowercase.
+func (m Module) usernameSkeleton(username string) string {
+	fold := cases.Fold()
+	return fold.String(m.usernames.Skeleton(fold.String(normalizeUsername(username))))
+}
+
+// checkUsernameRequirements validates a normalized username for the given
+// account, uuid.Nil for a new one, and returns its skeleton.
+func (m Module) checkUsernameRequirements(ctx context.Context, accountID uuid.UUID, username string) (string, error) {
+	length := utf8.RuneCountInString(username)
+	if length < 3 || length > 32 {
+		return "", errx.ErrorUsernameIsNotAllowed.Raise(
+			fmt.Errorf("username must be between 3 and 32 characters"),
+		)
+	}
+
+	for _, r := range username {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			return "", errx.ErrorUsernameIsNotAllowed.Raise(
+				fmt.Errorf("username contains invalid characters %s", string(r)),
+			)
+		}
+	}
+
+	skeleton := m.usernameSkeleton(username)
+	for _, reserved := range m.usernamePolicy.Reserved {
+		if m.usernameSkeleton(reserved) == skeleton {
+			return "", errx.ErrorUsernameIsNotAllowed.Raise(
+				fmt.Errorf("username %s is reserved", username),
+			)
+		}
+	}
+
+	existing, err := m.repo.GetAccountByUsernameSkeleton(ctx, skeleton)
+	switch {
+	case errors.Is(err, errx.ErrorAccountNotFound):
+	case err != nil:
+		return "", err
+	case existing.ID != accountID:
+		return "", errx.ErrorUsernameAlreadyTaken.Raise(
+			fmt.Errorf("username %s is too similar to the username of account %s", username, existing.ID),
+		)
+	}
+
+	// Accounts the backfill left without a skeleton are still compared by
+	// their username in any case.
+	existing, err = m.repo.GetAccountByUsernameFold(ctx, username)
+	switch {
+	case errors.Is(err, errx.ErrorAccountNotFound):
+	case err != nil:
+		return "", err
+	case existing.ID != accountID:
+		return "", errx.ErrorUsernameAlreadyTaken.Raise(
+			fmt.Errorf("username %s differs only in case from the username of account %s", username, existing.ID),
+		)
+	}
+
+	if m.usernamePolicy.Quarantine > 0 {
+		since := time.Now().UTC().Add(-m.usernamePolicy.Quarantine)
+		quarantined, err := m.repo.ExistsUsernameReleasedSince(ctx, skeleton, accountID, since)
+		if err != nil {
+			return "", err
+		}
+		if quarantined {
+			return "", errx.ErrorUsernameAlreadyTaken.Raise(
+				fmt.Errorf("username %s was recently given up by another account", username),
+			)
+		}
+	}
+
+	return skeleton, nil
+}
+
+// BackfillUsernameSkeletons stores skeletons of accounts created before they
+// were tracked. Accounts whose username is confusable with another one are
+// left without a skeleton and returned, they have to be renamed by hand.
+func (m Module) BackfillUsernameSkeletons(ctx context.Context, batchSize uint) (uint, []models.Account, error) {
+	var (
+		filled    uint
+		conflicts []models.Account
+		after     uuid.UUID
+	)
+
+	for ctx.Err() == nil {
+		accounts, err := m.repo.GetAccountsWithoutUsernameSkeleton(ctx, after, batchSize)
+		if err != nil {
+			return filled, conflicts, err
+		}
+
+		for _, account := range accounts {
+			after = account.ID
+			skeleton := m.usernameSkeleton(account.Username)
+
+			_, err = m.repo.GetAccountByUsernameSkeleton(ctx, skeleton)
+			switch {
+			case err == nil:
+				conflicts = append(conflicts, account)
+				continue
+			case !errors.Is(err, errx.ErrorAccountNotFound):
+				return filled, conflicts, err
+			}
+
+			if err = m.repo.SetAccountUsernameSkeleton(ctx, account.ID, skeleton); err != nil {
+				return filled, conflicts, err
+			}
+			filled++
+		}
+
+		if len(accounts) == 0 || uint(len(accounts)) < batchSize {
+			break
+		}
+	}
+
+	return filled, conflicts, ctx.Err()
+}
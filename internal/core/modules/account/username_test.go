@@ -0,0 +1,39 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/netbill/auth-svc/internal/confusables"
+)
+
+func TestUsernameSkeleton(t *testing.T) {
+	m := Module{usernames: confusables.Default()}
+
+	tests := []struct {
+		name string
+		a, b string
+		same bool
+	}{
+		{name: "identical", a: "alice", b: "alice", same: true},
+		{name: "case", a: "Alice", b: "aLICE", same: true},
+		{name: "fullwidth letters", a: "ａｌｉｃｅ", b: "alice", same: true},
+		{name: "ligature", a: "ﬁona", b: "fiona", same: true},
+		{name: "cyrillic look-alike", a: "аlice", b: "alice", same: true},
+		{name: "greek capital look-alike", a: "ΑLICE", b: "alice", same: true},
+		{name: "digit look-alike", a: "paypa1", b: "paypal", same: true},
+		{name: "letter pair look-alike", a: "rnodern", b: "modern", same: true},
+		{name: "full case folding", a: "Straße", b: "strasse", same: true},
+		{name: "different letters", a: "bob", b: "rob"},
+		{name: "different lengths", a: "alice", b: "alicia"},
+		{name: "separators differ", a: "john_doe", b: "john-doe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := m.usernameSkeleton(tt.a), m.usernameSkeleton(tt.b)
+			if (a == b) != tt.same {
+				t.Errorf("skeletons of %q and %q are %q and %q, want equal %t", tt.a, tt.b, a, b, tt.same)
+			}
+		})
+	}
+}
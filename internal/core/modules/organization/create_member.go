@@ -2,15 +2,37 @@ package organization
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
 )
 
+// CreateOrgMember replicates the member unless it or its organization is
+// deleted already, the creation event came late in that case.
 func (m Module) CreateOrgMember(ctx context.Context, member models.Member) error {
-	err := m.repo.CreateOrgMember(ctx, member)
-	if err != nil {
-		return err
+	return m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		buried, err := m.isMemberBuried(txCtx, member.ID, member.OrganizationID)
+		if err != nil {
+			return err
+		}
+		if buried {
+			return errx.ErrorOrgMemberDeleted.Raise(
+				fmt.Errorf("organization member %s or its organization %s is deleted", member.ID, member.OrganizationID),
+			)
+		}
+
+		return m.repo.CreateOrgMember(txCtx, member)
+	})
+}
+
+// isMemberBuried reports whether the member or its organization has a tombstone.
+func (m Module) isMemberBuried(ctx context.Context, memberID, organizationID uuid.UUID) (bool, error) {
+	buried, err := m.repo.ExistsOrgMemberTombstone(ctx, memberID)
+	if err != nil || buried {
+		return buried, err
 	}
 
-	return nil
+	return m.repo.ExistsOrganizationTombstone(ctx, organizationID)
 }
@@ -2,15 +2,40 @@ package organization
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
 )
 
-func (m Module) DeleteOrgMember(ctx context.Context, memberID uuid.UUID) error {
-	err := m.repo.DeleteOrgMember(ctx, memberID)
+// DeleteOrgMember removes the member from the replica and returns it, a
+// member that is not replicated is returned empty. Either way a tombstone
+// is left, so late events can't bring the member back.
+func (m Module) DeleteOrgMember(ctx context.Context, memberID uuid.UUID, deletedAt time.Time) (models.Member, error) {
+	var member models.Member
+
+	err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err := m.repo.CreateOrgMemberTombstone(txCtx, memberID, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		member, err = m.repo.GetOrgMember(txCtx, memberID)
+		switch {
+		case errors.Is(err, errx.ErrorOrgMemberNotFound):
+			member = models.Member{}
+			return nil
+		case err != nil:
+			return err
+		}
+
+		return m.repo.DeleteOrgMember(txCtx, memberID)
+	})
 	if err != nil {
-		return err
+		return models.Member{}, err
 	}
 
-	return nil
+	return member, nil
 }
@@ -0,0 +1,39 @@
+package organization
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+// DeleteOrganization removes all members of the organization from the replica
+// and returns them, tombstones are left for the organization and for each of
+// them.
+func (m Module) DeleteOrganization(
+	ctx context.Context,
+	organizationID uuid.UUID,
+	deletedAt time.Time,
+) ([]models.Member, error) {
+	var members []models.Member
+
+	err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		err := m.repo.CreateOrganizationTombstone(txCtx, organizationID, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		members, err = m.repo.GetOrgMembersByOrganization(txCtx, organizationID)
+		if err != nil {
+			return err
+		}
+
+		return m.repo.DeleteOrgMembersByOrganization(txCtx, organizationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
@@ -0,0 +1,114 @@
+package organization
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+type ReconcileMembersResult struct {
+	Replicated uint
+	Skipped    uint
+	Removed    uint
+
+	// RemovedAccounts are accounts that lost memberships, deletions of them
+	// may have been waiting for that.
+	RemovedAccounts []uuid.UUID
+}
+
+// ReconcileMembers brings the members replica in line with a snapshot taken
+// at takenAt. Members of accounts unknown to this service and members deleted
+// since the snapshot was taken are skipped. Replicated members missing from
+// the snapshot are removed and buried, unless events newer than the snapshot
+// updated them.
+func (m Module) ReconcileMembers(
+	ctx context.Context,
+	takenAt time.Time,
+	members []models.Member,
+) (ReconcileMembersResult, error) {
+	var res ReconcileMembersResult
+
+	err := m.repo.Transaction(ctx, func(txCtx context.Context) error {
+		res = ReconcileMembersResult{}
+
+		inSnapshot := make(map[uuid.UUID]struct{}, len(members))
+		for _, member := range members {
+			inSnapshot[member.ID] = struct{}{}
+
+			exists, err := m.repo.ExistsAccountByID(txCtx, member.AccountID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				res.Skipped++
+				continue
+			}
+
+			buried, err := m.isMemberBuried(txCtx, member.ID, member.OrganizationID)
+			if err != nil {
+				return err
+			}
+			if buried {
+				res.Skipped++
+				continue
+			}
+
+			if err = m.repo.CreateOrgMember(txCtx, member); err != nil {
+				return err
+			}
+
+			// Members replicated already are updated only if the replica
+			// holds an older version of them.
+			updatedAt := member.UpdatedAt
+			if updatedAt.IsZero() {
+				updatedAt = member.CreatedAt
+			}
+
+			err = m.repo.UpdateOrgMember(txCtx, member.ID, UpdateMemberParams{
+				Position:  member.Position,
+				Label:     member.Label,
+				UpdatedAt: updatedAt,
+			})
+			if err != nil {
+				return err
+			}
+			res.Replicated++
+		}
+
+		stale, err := m.repo.GetOrgMembersUpdatedBefore(txCtx, takenAt)
+		if err != nil {
+			return err
+		}
+
+		removed := make(map[uuid.UUID]struct{})
+		for _, member := range stale {
+			if _, ok := inSnapshot[member.ID]; ok {
+				continue
+			}
+
+			err = m.repo.CreateOrgMemberTombstone(txCtx, member.ID, takenAt)
+			if err != nil {
+				return err
+			}
+
+			if err = m.repo.DeleteOrgMember(txCtx, member.ID); err != nil {
+				return err
+			}
+			res.Removed++
+
+			if _, ok := removed[member.AccountID]; !ok {
+				removed[member.AccountID] = struct{}{}
+				res.RemovedAccounts = append(res.RemovedAccounts, member.AccountID)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ReconcileMembersResult{}, err
+	}
+
+	return res, nil
+}
@@ -2,20 +2,51 @@ package organization
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/models"
 )
 
 type Module struct {
-	repo repo
+	repo       repo
+	tombstones TombstonesConfig
 }
 
-func New(repo repo) *Module {
-	return &Module{repo: repo}
+type Config struct {
+	Tombstones TombstonesConfig
+}
+
+func New(repo repo, cfg Config) *Module {
+	return &Module{
+		repo:       repo,
+		tombstones: cfg.Tombstones,
+	}
+}
+
+type UpdateMemberParams struct {
+	Position  *string
+	Label     *string
+	UpdatedAt time.Time
 }
 
 type repo interface {
 	CreateOrgMember(ctx context.Context, member models.Member) error
+	UpdateOrgMember(ctx context.Context, memberID uuid.UUID, params UpdateMemberParams) error
+	GetOrgMember(ctx context.Context, memberID uuid.UUID) (models.Member, error)
 	DeleteOrgMember(ctx context.Context, memberID uuid.UUID) error
+	GetOrgMembersByOrganization(ctx context.Context, organizationID uuid.UUID) ([]models.Member, error)
+	DeleteOrgMembersByOrganization(ctx context.Context, organizationID uuid.UUID) error
+	GetOrgMembersUpdatedBefore(ctx context.Context, before time.Time) ([]models.Member, error)
+
+	CreateOrgMemberTombstone(ctx context.Context, memberID uuid.UUID, deletedAt time.Time) error
+	CreateOrganizationTombstone(ctx context.Context, organizationID uuid.UUID, deletedAt time.Time) error
+	ExistsOrgMemberTombstone(ctx context.Context, memberID uuid.UUID) (bool, error)
+	ExistsOrganizationTombstone(ctx context.Context, organizationID uuid.UUID) (bool, error)
+	DeleteOrgMemberTombstonesBefore(ctx context.Context, before time.Time, limit uint) (uint, error)
+	DeleteOrganizationTombstonesBefore(ctx context.Context, before time.Time, limit uint) (uint, error)
+
+	ExistsAccountByID(ctx context.Context, accountID uuid.UUID) (bool, error)
+
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
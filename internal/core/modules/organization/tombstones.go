@@ -0,0 +1,42 @@
+package organization
+
+import (
+	"context"
+	"time"
+)
+
+type TombstonesConfig struct {
+	// Retention is how long tombstones of deleted members and organizations
+	// are kept, zero keeps them forever. Events delivered later than that can
+	// bring deleted members back.
+	Retention time.Duration
+}
+
+// PruneTombstones drops tombstones of members and organizations deleted
+// longer ago than the retention and returns how many were dropped.
+func (m Module) PruneTombstones(ctx context.Context, batchSize uint) (uint, error) {
+	if m.tombstones.Retention <= 0 {
+		return 0, nil
+	}
+
+	before := time.Now().UTC().Add(-m.tombstones.Retention)
+
+	var total uint
+	for _, prune := range []func(context.Context, time.Time, uint) (uint, error){
+		m.repo.DeleteOrgMemberTombstonesBefore,
+		m.repo.DeleteOrganizationTombstonesBefore,
+	} {
+		for ctx.Err() == nil {
+			n, err := prune(ctx, before, batchSize)
+			total += n
+			if err != nil {
+				return total, err
+			}
+			if n == 0 || n < batchSize {
+				break
+			}
+		}
+	}
+
+	return total, ctx.Err()
+}
@@ -0,0 +1,31 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+)
+
+func (m Module) UpdateOrgMember(ctx context.Context, memberID uuid.UUID, params UpdateMemberParams) error {
+	err := m.repo.UpdateOrgMember(ctx, memberID, params)
+	if !errors.Is(err, errx.ErrorOrgMemberNotFound) {
+		return err
+	}
+
+	// A member missing from the replica is either not created yet or deleted,
+	// only the former is worth waiting for.
+	buried, terr := m.repo.ExistsOrgMemberTombstone(ctx, memberID)
+	if terr != nil {
+		return terr
+	}
+	if buried {
+		return errx.ErrorOrgMemberDeleted.Raise(
+			fmt.Errorf("organization member %s is deleted", memberID),
+		)
+	}
+
+	return err
+}
@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/netbill/logium"
+)
+
+type Config struct {
+	Name     string
+	Interval time.Duration
+}
+
+// Run calls fn every cfg.Interval until ctx is done. Failures are logged and
+// retried on the next tick, so fn has to be safe to repeat.
+func Run(ctx context.Context, log *logium.Logger, cfg Config, fn func(ctx context.Context) error) {
+	if cfg.Interval <= 0 {
+		log.Warnf("job %s is disabled, interval is not set", cfg.Name)
+		return
+	}
+
+	log.Infof("starting job %s, interval %s", cfg.Name, cfg.Interval)
+
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Warnf("job %s stopped", cfg.Name)
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil && ctx.Err() == nil {
+				log.WithError(err).Errorf("job %s failed", cfg.Name)
+			}
+		}
+	}
+}
@@ -15,10 +15,18 @@ type handlers interface {
 		ctx context.Context,
 		event inbox.Event,
 	) inbox.EventStatus
+	OrgMemberUpdated(
+		ctx context.Context,
+		event inbox.Event,
+	) inbox.EventStatus
 	OrgMemberDeleted(
 		ctx context.Context,
 		event inbox.Event,
 	) inbox.EventStatus
+	OrganizationDeleted(
+		ctx context.Context,
+		event inbox.Event,
+	) inbox.EventStatus
 }
 
 func (m Messenger) RunConsumer(ctx context.Context, handlers handlers) {
@@ -34,8 +42,13 @@ func (m Messenger) RunConsumer(ctx context.Context, handlers handlers) {
 	orgConsumer := consumer.New(m.log, m.pool, "auth-svc-org-consumer", consumer.OnUnknownDoNothing, m.addr...)
 
 	orgConsumer.Handle(contracts.OrgMemberCreatedEvent, handlers.OrgMemberCreated)
+	orgConsumer.Handle(contracts.OrgMemberUpdatedEvent, handlers.OrgMemberUpdated)
 	orgConsumer.Handle(contracts.OrgMemberDeletedEvent, handlers.OrgMemberDeleted)
 
+	orgLifecycleConsumer := consumer.New(m.log, m.pool, "auth-svc-org-lifecycle-consumer", consumer.OnUnknownDoNothing, m.addr...)
+
+	orgLifecycleConsumer.Handle(contracts.OrganizationDeletedEvent, handlers.OrganizationDeleted)
+
 	inboxer1 := consumer.NewInboxer(m.log, m.pool, consumer.ConfigInboxer{
 		Name:       "auth-svc-inbox-worker-1",
 		BatchSize:  10,
@@ -44,10 +57,16 @@ func (m Messenger) RunConsumer(ctx context.Context, handlers handlers) {
 		MaxSleep:   1 * time.Second,
 	})
 	inboxer1.Handle(contracts.OrgMemberCreatedEvent, handlers.OrgMemberCreated)
+	inboxer1.Handle(contracts.OrgMemberUpdatedEvent, handlers.OrgMemberUpdated)
 	inboxer1.Handle(contracts.OrgMemberDeletedEvent, handlers.OrgMemberDeleted)
+	inboxer1.Handle(contracts.OrganizationDeletedEvent, handlers.OrganizationDeleted)
+
+	run(func() {
+		orgConsumer.Run(ctx, contracts.AuthSvcGroup, contracts.OrgMemberTopicV1, m.addr...)
+	})
 
 	run(func() {
-		orgConsumer.Run(ctx, contracts.AuthSvcGroup, contracts.AccountsTopicV1, m.addr...)
+		orgLifecycleConsumer.Run(ctx, contracts.AuthSvcGroup, contracts.OrganizationTopicV1, m.addr...)
 	})
 
 	run(func() {
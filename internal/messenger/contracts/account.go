@@ -14,6 +14,7 @@ type AccountCreatedPayload struct {
 	AccountID uuid.UUID `json:"account_id"`
 	Username  string    `json:"username"`
 	Role      string    `json:"role"`
+	Version   int64     `json:"version"`
 
 	CreatedAt time.Time `json:"created_at"`
 }
@@ -23,9 +24,98 @@ const AccountUsernameUpdatedEvent = "account.username.updated"
 type AccountUsernameUpdatedPayload struct {
 	AccountID   uuid.UUID `json:"account_id"`
 	NewUsername string    `json:"new_username"`
+	OldUsername string    `json:"old_username,omitempty"`
+	Version     int64     `json:"version"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+const AccountPasswordUpdatedEvent = "account.password.updated"
+
+type AccountPasswordUpdatedPayload struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Version   int64     `json:"version"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+const AccountRoleUpdatedEvent = "account.role.updated"
+
+type AccountRoleUpdatedPayload struct {
+	AccountID uuid.UUID `json:"account_id"`
+	NewRole   string    `json:"new_role"`
+	Version   int64     `json:"version"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+const AccountStatusUpdatedEvent = "account.status.updated"
+
+type AccountStatusUpdatedPayload struct {
+	AccountID uuid.UUID  `json:"account_id"`
+	NewStatus string     `json:"new_status"`
+	Reason    *string    `json:"reason,omitempty"`
+	Until     *time.Time `json:"until,omitempty"`
+	Version   int64      `json:"version"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+const AccountLoginNewDeviceEvent = "account.login.new_device"
+
+// AccountLoginNewDevicePayload carries everything needed to let the account
+// owner know about a sign-in from a device they have not used before.
+type AccountLoginNewDevicePayload struct {
+	AccountID  uuid.UUID `json:"account_id"`
+	SessionID  uuid.UUID `json:"session_id"`
+	Username   string    `json:"username"`
+	Email      string    `json:"email"`
+	IP         string    `json:"ip,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	OS         string    `json:"os,omitempty"`
+	Browser    string    `json:"browser,omitempty"`
+	DeviceName *string   `json:"device_name,omitempty"`
+	LoggedInAt time.Time `json:"logged_in_at"`
+}
+
+const AccountDeletionScheduledEvent = "account.deletion.scheduled"
+
+// AccountDeletionScheduledPayload announces that the account is pending
+// deletion, it is deleted at PurgeAfter unless the owner restores it before.
+type AccountDeletionScheduledPayload struct {
+	AccountID   uuid.UUID `json:"account_id"`
+	RequestedAt time.Time `json:"requested_at"`
+	PurgeAfter  time.Time `json:"purge_after"`
+}
+
+const AccountDeletionCancelledEvent = "account.deletion.cancelled"
+
+type AccountDeletionCancelledPayload struct {
+	AccountID   uuid.UUID `json:"account_id"`
+	CancelledAt time.Time `json:"cancelled_at"`
+}
+
+const AccountDeletionRequestedEvent = "account.deletion.requested"
+
+// AccountDeletionRequestedPayload asks the organization service to remove the
+// memberships of an account being deleted, the account is deleted once the
+// last of them is gone. It is sent again on every attempt until TimeoutAt.
+type AccountDeletionRequestedPayload struct {
+	AccountID   uuid.UUID   `json:"account_id"`
+	MemberIDs   []uuid.UUID `json:"member_ids"`
+	Attempt     uint        `json:"attempt"`
+	RequestedAt time.Time   `json:"requested_at"`
+	TimeoutAt   *time.Time  `json:"timeout_at,omitempty"`
+}
+
+const AccountDeletionFailedEvent = "account.deletion.failed"
+
+// AccountDeletionFailedPayload tells that memberships of the account were not
+// removed in time. The account stays pending deletion until its owner logs in,
+// which restores it, or an administrator deletes it once the memberships are
+// gone.
+type AccountDeletionFailedPayload struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Attempts  uint      `json:"attempts"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
 const AccountDeletedEvent = "account.deleted"
 
 type AccountDeletedPayload struct {
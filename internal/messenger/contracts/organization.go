@@ -6,6 +6,15 @@ import (
 	"github.com/google/uuid"
 )
 
+const OrganizationTopicV1 = "organization.v1"
+
+const OrganizationDeletedEvent = "organization.deleted"
+
+type OrganizationDeletedPayload struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	DeletedAt      time.Time `json:"deleted_at"`
+}
+
 const OrgMemberTopicV1 = "organization.member.v1"
 
 const OrgMemberCreatedEvent = "member.created"
@@ -34,3 +43,15 @@ type OrgMemberDeletedPayload struct {
 	MemberID  uuid.UUID `json:"member_id"`
 	DeletedAt time.Time `json:"deleted_at"`
 }
+
+// OrgMemberSnapshot is a single line of the organization members snapshot file
+// used to rebuild the replica, the file is in JSON Lines format.
+type OrgMemberSnapshot struct {
+	MemberID       uuid.UUID `json:"member_id"`
+	AccountID      uuid.UUID `json:"account_id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Position       *string   `json:"position,omitempty"`
+	Label          *string   `json:"label,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
@@ -0,0 +1,57 @@
+package contracts
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const SessionsTopicV1 = "sessions.v1"
+
+const SessionExpiredEvent = "session.expired"
+
+type SessionExpiredPayload struct {
+	SessionID uuid.UUID `json:"session_id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Reason    string    `json:"reason"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+const SessionEvictedEvent = "session.evicted"
+
+type SessionEvictedPayload struct {
+	SessionID          uuid.UUID `json:"session_id"`
+	AccountID          uuid.UUID `json:"account_id"`
+	EvictedBySessionID uuid.UUID `json:"evicted_by_session_id"`
+	EvictedAt          time.Time `json:"evicted_at"`
+}
+
+const SessionCreatedEvent = "session.created"
+
+type SessionCreatedPayload struct {
+	SessionID  uuid.UUID `json:"session_id"`
+	AccountID  uuid.UUID `json:"account_id"`
+	IP         string    `json:"ip,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	OS         string    `json:"os,omitempty"`
+	Browser    string    `json:"browser,omitempty"`
+	DeviceName *string   `json:"device_name,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+const SessionDeletedEvent = "session.deleted"
+
+type SessionDeletedPayload struct {
+	SessionID uuid.UUID `json:"session_id"`
+	AccountID uuid.UUID `json:"account_id"`
+	Reason    string    `json:"reason"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+const AccountSessionsRevokedEvent = "account.sessions.revoked"
+
+type AccountSessionsRevokedPayload struct {
+	AccountID uuid.UUID `json:"account_id"`
+	Reason    string    `json:"reason"`
+	RevokedAt time.Time `json:"revoked_at"`
+}
@@ -25,9 +25,16 @@ func (i Inbound) OrgMemberCreated(
 		ID:             payload.MemberID,
 		AccountID:      payload.AccountID,
 		OrganizationID: payload.OrganizationID,
+		Position:       payload.Position,
+		Label:          payload.Label,
 		CreatedAt:      payload.CreatedAt,
+		UpdatedAt:      payload.CreatedAt,
 	}); err != nil {
 		switch {
+		case errors.Is(err, errx.ErrorOrgMemberDeleted):
+			// creation came after the member or its organization was deleted
+			i.log.Warnf("dropping creation of deleted member, key %s, id: %s, error: %v", event.Key, event.ID, err)
+			return inbox.EventStatusProcessed
 		case errors.Is(err, errx.ErrorInternal):
 			i.log.Errorf(
 				"failed to create member due to internal error, key %s, id: %s, error: %v",
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/messenger/contracts"
 	"github.com/netbill/evebox/box/inbox"
@@ -20,7 +21,8 @@ func (i Inbound) OrgMemberDeleted(
 		return inbox.EventStatusFailed
 	}
 
-	if err := i.domain.DeleteOrgMember(ctx, payload.MemberID); err != nil {
+	member, err := i.domain.DeleteOrgMember(ctx, payload.MemberID, payload.DeletedAt)
+	if err != nil {
 		switch {
 		case errors.Is(err, errx.ErrorInternal):
 			i.log.Errorf(
@@ -34,5 +36,19 @@ func (i Inbound) OrgMemberDeleted(
 		}
 	}
 
+	if member.AccountID == uuid.Nil {
+		return inbox.EventStatusProcessed
+	}
+
+	// The member is gone already, so a retry could not tell whose deletion to
+	// continue. A failure is left to the purge job, which checks the account
+	// again once its wait for memberships removal times out.
+	if err = i.accounts.ContinueAccountDeletion(ctx, member.AccountID); err != nil {
+		i.log.Errorf(
+			"failed to continue deletion of account %s, key %s, id: %s, error: %v",
+			member.AccountID, event.Key, event.ID, err,
+		)
+	}
+
 	return inbox.EventStatusProcessed
 }
@@ -0,0 +1,50 @@
+package inbound
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/box/inbox"
+)
+
+func (i Inbound) OrganizationDeleted(
+	ctx context.Context,
+	event inbox.Event,
+) inbox.EventStatus {
+	var payload contracts.OrganizationDeletedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		i.log.Errorf("bad payload for %s, key %s, id: %s, error: %v", event.Type, event.Key, event.ID, err)
+		return inbox.EventStatusFailed
+	}
+
+	members, err := i.domain.DeleteOrganization(ctx, payload.OrganizationID, payload.DeletedAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorInternal):
+			i.log.Errorf(
+				"failed to delete organization members due to internal error, key %s, id: %s, error: %v",
+				event.Key, event.ID, err,
+			)
+			return inbox.EventStatusPending
+		default:
+			i.log.Errorf("failed to delete organization members, key %s, id: %s, error: %v", event.Key, event.ID, err)
+			return inbox.EventStatusFailed
+		}
+	}
+
+	// As in OrgMemberDeleted, the members are gone already and failures are
+	// left to the purge job.
+	for _, member := range members {
+		if err = i.accounts.ContinueAccountDeletion(ctx, member.AccountID); err != nil {
+			i.log.Errorf(
+				"failed to continue deletion of account %s, key %s, id: %s, error: %v",
+				member.AccountID, event.Key, event.ID, err,
+			)
+		}
+	}
+
+	return inbox.EventStatusProcessed
+}
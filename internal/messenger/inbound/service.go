@@ -2,25 +2,35 @@ package inbound
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/organization"
 	"github.com/netbill/logium"
 )
 
 type Inbound struct {
-	log    *logium.Logger
-	domain domain
+	log      *logium.Logger
+	domain   domain
+	accounts accounts
 }
 
-func New(log *logium.Logger, domain domain) Inbound {
+func New(log *logium.Logger, domain domain, accounts accounts) Inbound {
 	return Inbound{
-		log:    log,
-		domain: domain,
+		log:      log,
+		domain:   domain,
+		accounts: accounts,
 	}
 }
 
 type domain interface {
 	CreateOrgMember(ctx context.Context, member models.Member) error
-	DeleteOrgMember(ctx context.Context, memberID uuid.UUID) error
+	UpdateOrgMember(ctx context.Context, memberID uuid.UUID, params organization.UpdateMemberParams) error
+	DeleteOrgMember(ctx context.Context, memberID uuid.UUID, deletedAt time.Time) (models.Member, error)
+	DeleteOrganization(ctx context.Context, organizationID uuid.UUID, deletedAt time.Time) ([]models.Member, error)
+}
+
+type accounts interface {
+	ContinueAccountDeletion(ctx context.Context, accountID uuid.UUID) error
 }
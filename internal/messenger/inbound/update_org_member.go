@@ -0,0 +1,51 @@
+package inbound
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/modules/organization"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/box/inbox"
+)
+
+func (i Inbound) OrgMemberUpdated(
+	ctx context.Context,
+	event inbox.Event,
+) inbox.EventStatus {
+	var payload contracts.OrgMemberUpdatedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		i.log.Errorf("bad payload for %s, key %s, id: %s, error: %v", event.Type, event.Key, event.ID, err)
+		return inbox.EventStatusFailed
+	}
+
+	if err := i.domain.UpdateOrgMember(ctx, payload.MemberID, organization.UpdateMemberParams{
+		Position:  payload.Position,
+		Label:     payload.Label,
+		UpdatedAt: payload.UpdatedAt,
+	}); err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorOrgMemberDeleted):
+			// member was deleted before the update got here, nothing to update anymore
+			i.log.Warnf("dropping update of deleted member, key %s, id: %s, error: %v", event.Key, event.ID, err)
+			return inbox.EventStatusProcessed
+		case errors.Is(err, errx.ErrorOrgMemberNotFound):
+			// update overtook the creation event, retry once the member is replicated
+			i.log.Warnf("member for update not found yet, key %s, id: %s, error: %v", event.Key, event.ID, err)
+			return inbox.EventStatusPending
+		case errors.Is(err, errx.ErrorInternal):
+			i.log.Errorf(
+				"failed to update member due to internal error, key %s, id: %s, error: %v",
+				event.Key, event.ID, err,
+			)
+			return inbox.EventStatusPending
+		default:
+			i.log.Errorf("failed to update member, key %s, id: %s, error: %v", event.Key, event.ID, err)
+			return inbox.EventStatusFailed
+		}
+	}
+
+	return inbox.EventStatusProcessed
+}
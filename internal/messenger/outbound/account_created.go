@@ -20,6 +20,7 @@ func (p Outbound) WriteAccountCreated(
 		AccountID: account.ID,
 		Username:  account.Username,
 		Role:      account.Role,
+		Version:   account.Version,
 		CreatedAt: account.CreatedAt,
 	})
 	if err != nil {
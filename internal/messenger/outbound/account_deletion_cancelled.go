@@ -0,0 +1,49 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/header"
+	"github.com/segmentio/kafka-go"
+)
+
+func (p Outbound) WriteAccountDeletionCancelled(
+	ctx context.Context,
+	accountID uuid.UUID,
+) error {
+	payload, err := json.Marshal(contracts.AccountDeletionCancelledPayload{
+		AccountID:   accountID,
+		CancelledAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal account deletion cancelled payload, cause: %w", err)
+	}
+
+	event, err := p.outbox.CreateOutboxEvent(
+		ctx,
+		kafka.Message{
+			Topic: contracts.AccountsTopicV1,
+			Key:   []byte(accountID.String()),
+			Value: payload,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(uuid.New().String())},
+				{Key: header.EventType, Value: []byte(contracts.AccountDeletionCancelledEvent)},
+				{Key: header.EventVersion, Value: []byte("1")},
+				{Key: header.Producer, Value: []byte(contracts.AuthSvcGroup)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event for account deletion cancelled event, cause: %w", err)
+	}
+
+	p.log.Debugf("created outbox event %s for account %s, id %s", contracts.AccountDeletionCancelledEvent, event.ID.String(), accountID.String())
+
+	return err
+}
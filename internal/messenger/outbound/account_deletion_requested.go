@@ -0,0 +1,58 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/header"
+	"github.com/segmentio/kafka-go"
+)
+
+func (p Outbound) WriteAccountDeletionRequested(
+	ctx context.Context,
+	deletion models.AccountDeletion,
+	members []models.Member,
+) error {
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.ID)
+	}
+
+	payload, err := json.Marshal(contracts.AccountDeletionRequestedPayload{
+		AccountID:   deletion.AccountID,
+		MemberIDs:   memberIDs,
+		Attempt:     deletion.Attempts,
+		RequestedAt: deletion.UpdatedAt,
+		TimeoutAt:   deletion.TimeoutAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal account deletion requested payload, cause: %w", err)
+	}
+
+	event, err := p.outbox.CreateOutboxEvent(
+		ctx,
+		kafka.Message{
+			Topic: contracts.AccountsTopicV1,
+			Key:   []byte(deletion.AccountID.String()),
+			Value: payload,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(uuid.New().String())},
+				{Key: header.EventType, Value: []byte(contracts.AccountDeletionRequestedEvent)},
+				{Key: header.EventVersion, Value: []byte("1")},
+				{Key: header.Producer, Value: []byte(contracts.AuthSvcGroup)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event for account deletion requested event, cause: %w", err)
+	}
+
+	p.log.Debugf("created outbox event %s for account %s, id %s", contracts.AccountDeletionRequestedEvent, event.ID.String(), deletion.AccountID.String())
+
+	return err
+}
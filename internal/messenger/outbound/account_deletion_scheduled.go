@@ -0,0 +1,50 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/header"
+	"github.com/segmentio/kafka-go"
+)
+
+func (p Outbound) WriteAccountDeletionScheduled(
+	ctx context.Context,
+	deletion models.AccountDeletion,
+) error {
+	payload, err := json.Marshal(contracts.AccountDeletionScheduledPayload{
+		AccountID:   deletion.AccountID,
+		RequestedAt: deletion.RequestedAt,
+		PurgeAfter:  deletion.PurgeAfter,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal account deletion scheduled payload, cause: %w", err)
+	}
+
+	event, err := p.outbox.CreateOutboxEvent(
+		ctx,
+		kafka.Message{
+			Topic: contracts.AccountsTopicV1,
+			Key:   []byte(deletion.AccountID.String()),
+			Value: payload,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(uuid.New().String())},
+				{Key: header.EventType, Value: []byte(contracts.AccountDeletionScheduledEvent)},
+				{Key: header.EventVersion, Value: []byte("1")},
+				{Key: header.Producer, Value: []byte(contracts.AuthSvcGroup)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event for account deletion scheduled event, cause: %w", err)
+	}
+
+	p.log.Debugf("created outbox event %s for account %s, id %s", contracts.AccountDeletionScheduledEvent, event.ID.String(), deletion.AccountID.String())
+
+	return err
+}
@@ -0,0 +1,59 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/header"
+	"github.com/segmentio/kafka-go"
+)
+
+func (p Outbound) WriteAccountLoginNewDevice(
+	ctx context.Context,
+	account models.Account,
+	email models.AccountEmail,
+	session models.Session,
+) error {
+	payload, err := json.Marshal(contracts.AccountLoginNewDevicePayload{
+		AccountID:  account.ID,
+		SessionID:  session.ID,
+		Username:   account.Username,
+		Email:      email.Email,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		OS:         session.OS,
+		Browser:    session.Browser,
+		DeviceName: session.DeviceName,
+		LoggedInAt: session.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal account login new device payload, cause: %w", err)
+	}
+
+	event, err := p.outbox.CreateOutboxEvent(
+		ctx,
+		kafka.Message{
+			Topic: contracts.AccountsTopicV1,
+			Key:   []byte(account.ID.String()),
+			Value: payload,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(uuid.New().String())},
+				{Key: header.EventType, Value: []byte(contracts.AccountLoginNewDeviceEvent)},
+				{Key: header.EventVersion, Value: []byte("1")},
+				{Key: header.Producer, Value: []byte(contracts.AuthSvcGroup)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event for account login new device event, cause: %w", err)
+	}
+
+	p.log.Debugf("created outbox event %s for account %s, id %s", contracts.AccountLoginNewDeviceEvent, event.ID.String(), account.ID.String())
+
+	return nil
+}
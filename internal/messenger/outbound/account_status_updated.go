@@ -0,0 +1,53 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/header"
+	"github.com/segmentio/kafka-go"
+)
+
+func (p Outbound) WriteAccountStatusUpdated(
+	ctx context.Context,
+	account models.Account,
+) error {
+	payload, err := json.Marshal(contracts.AccountStatusUpdatedPayload{
+		AccountID: account.ID,
+		NewStatus: account.Status,
+		Reason:    account.StatusReason,
+		Until:     account.StatusUntil,
+		Version:   account.Version,
+		UpdatedAt: account.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal account status updated payload, cause: %w", err)
+	}
+
+	event, err := p.outbox.CreateOutboxEvent(
+		ctx,
+		kafka.Message{
+			Topic: contracts.AccountsTopicV1,
+			Key:   []byte(account.ID.String()),
+			Value: payload,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(uuid.New().String())},
+				{Key: header.EventType, Value: []byte(contracts.AccountStatusUpdatedEvent)},
+				{Key: header.EventVersion, Value: []byte("1")},
+				{Key: header.Producer, Value: []byte(contracts.AuthSvcGroup)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event for account status updated event, cause: %w", err)
+	}
+
+	p.log.Debugf("created outbox event %s for account %s, id %s", contracts.AccountStatusUpdatedEvent, event.ID.String(), account.ID.String())
+
+	return err
+}
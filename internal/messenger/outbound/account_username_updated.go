@@ -15,10 +15,13 @@ import (
 func (p Outbound) WriteAccountUsernameUpdated(
 	ctx context.Context,
 	account models.Account,
+	oldUsername string,
 ) error {
 	payload, err := json.Marshal(contracts.AccountUsernameUpdatedPayload{
 		AccountID:   account.ID,
 		NewUsername: account.Username,
+		OldUsername: oldUsername,
+		Version:     account.Version,
 		UpdatedAt:   account.UpdatedAt,
 	})
 	if err != nil {
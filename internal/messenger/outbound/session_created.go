@@ -0,0 +1,55 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/header"
+	"github.com/segmentio/kafka-go"
+)
+
+func (p Outbound) WriteSessionCreated(
+	ctx context.Context,
+	session models.Session,
+) error {
+	payload, err := json.Marshal(contracts.SessionCreatedPayload{
+		SessionID:  session.ID,
+		AccountID:  session.AccountID,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		OS:         session.OS,
+		Browser:    session.Browser,
+		DeviceName: session.DeviceName,
+		CreatedAt:  session.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal session created payload, cause: %w", err)
+	}
+
+	event, err := p.outbox.CreateOutboxEvent(
+		ctx,
+		kafka.Message{
+			Topic: contracts.SessionsTopicV1,
+			Key:   []byte(session.AccountID.String()),
+			Value: payload,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(uuid.New().String())},
+				{Key: header.EventType, Value: []byte(contracts.SessionCreatedEvent)},
+				{Key: header.EventVersion, Value: []byte("1")},
+				{Key: header.Producer, Value: []byte(contracts.AuthSvcGroup)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event for session created event, cause: %w", err)
+	}
+
+	p.log.Debugf("created outbox event %s for session %s, id %s", contracts.SessionCreatedEvent, event.ID.String(), session.ID.String())
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package outbound
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/messenger/contracts"
+	"github.com/netbill/evebox/header"
+	"github.com/segmentio/kafka-go"
+)
+
+func (p Outbound) WriteSessionDeleted(
+	ctx context.Context,
+	session models.Session,
+	reason string,
+) error {
+	payload, err := json.Marshal(contracts.SessionDeletedPayload{
+		SessionID: session.ID,
+		AccountID: session.AccountID,
+		Reason:    reason,
+		DeletedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal session deleted payload, cause: %w", err)
+	}
+
+	event, err := p.outbox.CreateOutboxEvent(
+		ctx,
+		kafka.Message{
+			Topic: contracts.SessionsTopicV1,
+			Key:   []byte(session.AccountID.String()),
+			Value: payload,
+			Headers: []kafka.Header{
+				{Key: header.EventID, Value: []byte(uuid.New().String())},
+				{Key: header.EventType, Value: []byte(contracts.SessionDeletedEvent)},
+				{Key: header.EventVersion, Value: []byte("1")},
+				{Key: header.Producer, Value: []byte(contracts.AuthSvcGroup)},
+				{Key: header.ContentType, Value: []byte("application/json")},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event for session deleted event, cause: %w", err)
+	}
+
+	p.log.Debugf("created outbox event %s for session %s, id %s", contracts.SessionDeletedEvent, event.ID.String(), session.ID.String())
+
+	return nil
+}
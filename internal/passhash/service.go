@@ -0,0 +1,103 @@
+package passhash
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	defaultMemory      = 64 * 1024
+	defaultIterations  = 3
+	defaultParallelism = 2
+	defaultSaltLength  = 16
+	defaultKeyLength   = 32
+)
+
+var ErrUnsupportedHash = errors.New("unsupported password hash format")
+
+// Service hashes new passwords with argon2id and verifies both argon2id and
+// legacy bcrypt hashes, all stored as PHC-like strings.
+type Service struct {
+	params argon2Params
+}
+
+type Config struct {
+	// Memory is the argon2id memory cost in KiB.
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+
+	SaltLength uint32
+	KeyLength  uint32
+}
+
+// NewHasher builds a hasher, zero config values fall back to the defaults
+// recommended for argon2id.
+func NewHasher(cfg Config) Service {
+	params := argon2Params{
+		memory:      cfg.Memory,
+		iterations:  cfg.Iterations,
+		parallelism: cfg.Parallelism,
+		saltLength:  cfg.SaltLength,
+		keyLength:   cfg.KeyLength,
+	}
+	if params.memory == 0 {
+		params.memory = defaultMemory
+	}
+	if params.iterations == 0 {
+		params.iterations = defaultIterations
+	}
+	if params.parallelism == 0 {
+		params.parallelism = defaultParallelism
+	}
+	if params.saltLength == 0 {
+		params.saltLength = defaultSaltLength
+	}
+	if params.keyLength == 0 {
+		params.keyLength = defaultKeyLength
+	}
+
+	return Service{params: params}
+}
+
+func (s Service) Hash(password string) (string, error) {
+	hash, err := hashArgon2id(password, s.params)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password, cause: %w", err)
+	}
+
+	return hash, nil
+}
+
+// Verify reports whether the password matches the hash, an error means the
+// hash itself could not be checked.
+func (s Service) Verify(hash, password string) (bool, error) {
+	switch {
+	case strings.HasPrefix(hash, argon2idPrefix):
+		return verifyArgon2id(hash, password)
+	case isBcrypt(hash):
+		return verifyBcrypt(hash, password)
+	default:
+		return false, ErrUnsupportedHash
+	}
+}
+
+// NeedsRehash reports whether the hash was made by another algorithm or with
+// parameters different from the current ones.
+func (s Service) NeedsRehash(hash string) bool {
+	if !strings.HasPrefix(hash, argon2idPrefix) {
+		return true
+	}
+
+	params, _, _, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.memory != s.params.memory ||
+		params.iterations != s.params.iterations ||
+		params.parallelism != s.params.parallelism ||
+		params.saltLength != s.params.saltLength ||
+		params.keyLength != s.params.keyLength
+}
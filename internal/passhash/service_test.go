@@ -0,0 +1,158 @@
+package passhash
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testConfig = Config{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func bcryptHash(t *testing.T, password, prefix string) string {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return prefix + strings.TrimPrefix(string(hash), "$2a$")
+}
+
+func TestServiceHash(t *testing.T) {
+	s := NewHasher(testConfig)
+
+	first, err := s.Hash("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(first, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Errorf("Hash() = %q, want an argon2id hash with the configured parameters", first)
+	}
+
+	second, err := s.Hash("correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Error("Hash() returned the same hash twice, salts are not random")
+	}
+}
+
+func TestServiceVerify(t *testing.T) {
+	s := NewHasher(testConfig)
+
+	argon2id, err := s.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		want     bool
+		err      error
+	}{
+		{name: "argon2id match", hash: argon2id, password: "secret", want: true},
+		{name: "argon2id mismatch", hash: argon2id, password: "Secret"},
+		{name: "bcrypt 2a match", hash: bcryptHash(t, "secret", "$2a$"), password: "secret", want: true},
+		{name: "bcrypt 2b match", hash: bcryptHash(t, "secret", "$2b$"), password: "secret", want: true},
+		{name: "bcrypt 2y match", hash: bcryptHash(t, "secret", "$2y$"), password: "secret", want: true},
+		{name: "bcrypt mismatch", hash: bcryptHash(t, "secret", "$2a$"), password: "other"},
+		{name: "unknown format", hash: "5ebe2294ecd0e0f08eab7690d2a6ee69", password: "secret", err: ErrUnsupportedHash},
+		{name: "empty hash", hash: "", password: "secret", err: ErrUnsupportedHash},
+		{
+			name:     "malformed argon2id",
+			hash:     "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA",
+			password: "secret",
+			err:      ErrUnsupportedHash,
+		},
+		{
+			name:     "unsupported argon2id version",
+			hash:     strings.Replace(argon2id, "v=19", "v=16", 1),
+			password: "secret",
+			err:      ErrUnsupportedHash,
+		},
+		{
+			name:     "zero argon2id iterations",
+			hash:     strings.Replace(argon2id, "t=1", "t=0", 1),
+			password: "secret",
+			err:      ErrUnsupportedHash,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Verify(tt.hash, tt.password)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("Verify() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceNeedsRehash(t *testing.T) {
+	s := NewHasher(testConfig)
+
+	current, err := s.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hashWith := func(cfg Config) string {
+		hash, err := NewHasher(cfg).Hash("secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+
+	tests := []struct {
+		name string
+		hash string
+		want bool
+	}{
+		{name: "current parameters", hash: current},
+		{
+			name: "other memory",
+			hash: hashWith(Config{Memory: 2048, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}),
+			want: true,
+		},
+		{
+			name: "other iterations",
+			hash: hashWith(Config{Memory: 1024, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}),
+			want: true,
+		},
+		{
+			name: "other parallelism",
+			hash: hashWith(Config{Memory: 1024, Iterations: 1, Parallelism: 2, SaltLength: 16, KeyLength: 32}),
+			want: true,
+		},
+		{
+			name: "other salt length",
+			hash: hashWith(Config{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 8, KeyLength: 32}),
+			want: true,
+		},
+		{
+			name: "other key length",
+			hash: hashWith(Config{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 16}),
+			want: true,
+		},
+		{name: "bcrypt", hash: bcryptHash(t, "secret", "$2a$"), want: true},
+		{name: "malformed argon2id", hash: "$argon2id$v=19$broken", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,17 +13,28 @@ import (
 	"github.com/netbill/auth-svc/internal/core/models"
 	"github.com/netbill/auth-svc/internal/core/modules/account"
 	"github.com/netbill/auth-svc/internal/repository/pgdb"
+	"github.com/netbill/restkit/pagi"
 )
 
+// accountUsernameConstraints keep two accounts from sharing a username or
+// usernames that look alike.
+var accountUsernameConstraints = []string{"accounts_username_key", "accounts_username_skeleton_key"}
+
 func (r Repository) CreateAccount(ctx context.Context, params account.CreateAccountParams) (models.Account, error) {
 	now := time.Now().UTC()
 	accountID := uuid.New()
 
 	acc, err := r.accountsQ(ctx).Insert(ctx, pgdb.InsertAccountParams{
-		ID:       accountID,
-		Username: params.Username,
-		Role:     params.Role,
+		ID:               accountID,
+		Username:         params.Username,
+		UsernameSkeleton: params.UsernameSkeleton,
+		Role:             params.Role,
 	})
+	if isUniqueViolation(err, accountUsernameConstraints...) {
+		return models.Account{}, errx.ErrorUsernameAlreadyTaken.Raise(
+			fmt.Errorf("username %s is already taken, cause: %w", params.Username, err),
+		)
+	}
 	if err != nil {
 		return models.Account{}, fmt.Errorf("failed to insert account, cause: %w", err)
 	}
@@ -41,10 +52,12 @@ func (r Repository) CreateAccount(ctx context.Context, params account.CreateAcco
 	}
 
 	passwordRow := pgdb.AccountPassword{
-		AccountID: pgtype.UUID{Bytes: [16]byte(accountID), Valid: true},
-		Hash:      pgtype.Text{String: params.PasswordHash, Valid: true},
-		CreatedAt: pgtype.Timestamptz{Time: now, Valid: true},
-		UpdatedAt: pgtype.Timestamptz{Time: now, Valid: true},
+		AccountID:  pgtype.UUID{Bytes: [16]byte(accountID), Valid: true},
+		Hash:       pgtype.Text{String: params.PasswordHash, Valid: true},
+		MustChange: pgtype.Bool{Bool: false, Valid: true},
+		LastChange: pgtype.Text{String: models.PasswordChangeRegistration, Valid: true},
+		CreatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:  pgtype.Timestamptz{Time: now, Valid: true},
 	}
 
 	if _, err = r.passwordsQ(ctx).Insert(ctx, passwordRow); err != nil {
@@ -57,7 +70,7 @@ func (r Repository) CreateAccount(ctx context.Context, params account.CreateAcco
 func (r Repository) GetAccountByID(ctx context.Context, accountID uuid.UUID) (models.Account, error) {
 	acc, err := r.accountsQ(ctx).FilterID(accountID).Get(ctx)
 	switch {
-	case errors.Is(err, pgx.ErrNoRows):
+	case errors.Is(err, pgx.ErrNoRows) || (err == nil && !acc.ID.Valid):
 		return models.Account{}, errx.ErrorAccountNotFound.Raise(
 			fmt.Errorf("account with id %s not found", accountID),
 		)
@@ -68,6 +81,75 @@ func (r Repository) GetAccountByID(ctx context.Context, accountID uuid.UUID) (mo
 	return acc.ToModel(), nil
 }
 
+// LockAccount holds the account row until the surrounding transaction ends,
+// serializing concurrent changes of the account's dependent rows.
+func (r Repository) LockAccount(ctx context.Context, accountID uuid.UUID) error {
+	acc, err := r.accountsQ(ctx).FilterID(accountID).ForUpdate().Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock account %s, cause: %w", accountID, err)
+	}
+	if !acc.ID.Valid {
+		return errx.ErrorAccountNotFound.Raise(
+			fmt.Errorf("account with id %s not found", accountID),
+		)
+	}
+
+	return nil
+}
+
+func (r Repository) FilterAccounts(
+	ctx context.Context,
+	filter account.AccountsFilter,
+	limit, offset uint,
+) (pagi.Page[[]models.Account], error) {
+	apply := func(q pgdb.AccountsQ) pgdb.AccountsQ {
+		if filter.Role != nil {
+			q = q.FilterRole(*filter.Role)
+		}
+		if filter.Status != nil {
+			q = q.FilterStatus(*filter.Status)
+		}
+		if filter.UsernamePrefix != nil {
+			q = q.FilterUsernamePrefix(*filter.UsernamePrefix)
+		}
+		if filter.Email != nil {
+			q = q.FilterEmail(*filter.Email)
+		}
+		if filter.CreatedAfter != nil {
+			q = q.FilterCreatedAfter(*filter.CreatedAfter)
+		}
+		if filter.CreatedBefore != nil {
+			q = q.FilterCreatedBefore(*filter.CreatedBefore)
+		}
+		return q
+	}
+
+	rows, err := apply(r.accountsQ(ctx)).
+		OrderCreatedAt(false).
+		Page(limit, offset).
+		Select(ctx)
+	if err != nil {
+		return pagi.Page[[]models.Account]{}, fmt.Errorf("failed to filter accounts, cause: %w", err)
+	}
+
+	total, err := apply(r.accountsQ(ctx)).Count(ctx)
+	if err != nil {
+		return pagi.Page[[]models.Account]{}, fmt.Errorf("failed to count filtered accounts, cause: %w", err)
+	}
+
+	collection := make([]models.Account, 0, len(rows))
+	for _, a := range rows {
+		collection = append(collection, a.ToModel())
+	}
+
+	return pagi.Page[[]models.Account]{
+		Data:  collection,
+		Page:  uint(offset/limit) + 1,
+		Size:  uint(len(collection)),
+		Total: total,
+	}, nil
+}
+
 func (r Repository) ExistsAccountByID(ctx context.Context, accountID uuid.UUID) (bool, error) {
 	exist, err := r.accountsQ(ctx).FilterID(accountID).Exists(ctx)
 	if err != nil {
@@ -80,7 +162,7 @@ func (r Repository) ExistsAccountByID(ctx context.Context, accountID uuid.UUID)
 func (r Repository) GetAccountByEmail(ctx context.Context, email string) (models.Account, error) {
 	acc, err := r.accountsQ(ctx).FilterEmail(email).Get(ctx)
 	switch {
-	case errors.Is(err, pgx.ErrNoRows):
+	case errors.Is(err, pgx.ErrNoRows) || (err == nil && !acc.ID.Valid):
 		return models.Account{}, errx.ErrorAccountNotFound.Raise(
 			fmt.Errorf("account with email %s not found", email),
 		)
@@ -103,7 +185,7 @@ func (r Repository) ExistsAccountByEmail(ctx context.Context, email string) (boo
 func (r Repository) GetAccountByUsername(ctx context.Context, username string) (models.Account, error) {
 	acc, err := r.accountsQ(ctx).FilterUsername(username).Get(ctx)
 	switch {
-	case errors.Is(err, pgx.ErrNoRows):
+	case errors.Is(err, pgx.ErrNoRows) || (err == nil && !acc.ID.Valid):
 		return models.Account{}, errx.ErrorAccountNotFound.Raise(
 			fmt.Errorf("account with username %s not found", username),
 		)
@@ -114,13 +196,67 @@ func (r Repository) GetAccountByUsername(ctx context.Context, username string) (
 	return acc.ToModel(), nil
 }
 
-func (r Repository) ExistsAccountByUsername(ctx context.Context, username string) (bool, error) {
-	exist, err := r.accountsQ(ctx).FilterUsername(username).Exists(ctx)
+// GetAccountByUsernameFold finds an account whose username differs from the
+// given one in case only.
+func (r Repository) GetAccountByUsernameFold(ctx context.Context, username string) (models.Account, error) {
+	acc, err := r.accountsQ(ctx).FilterUsernameFold(username).Get(ctx)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows) || (err == nil && !acc.ID.Valid):
+		return models.Account{}, errx.ErrorAccountNotFound.Raise(
+			fmt.Errorf("account with username %s in any case not found", username),
+		)
+	case err != nil:
+		return models.Account{}, fmt.Errorf("failed to get account by username in any case, cause: %w", err)
+	}
+
+	return acc.ToModel(), nil
+}
+
+func (r Repository) GetAccountByUsernameSkeleton(ctx context.Context, skeleton string) (models.Account, error) {
+	acc, err := r.accountsQ(ctx).FilterUsernameSkeleton(skeleton).Get(ctx)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows) || (err == nil && !acc.ID.Valid):
+		return models.Account{}, errx.ErrorAccountNotFound.Raise(
+			fmt.Errorf("account with username skeleton %q not found", skeleton),
+		)
+	case err != nil:
+		return models.Account{}, fmt.Errorf("failed to get account by username skeleton, cause: %w", err)
+	}
+
+	return acc.ToModel(), nil
+}
+
+// GetAccountsWithoutUsernameSkeleton returns up to limit accounts ordered by
+// id after the given one, whose username skeleton was never stored.
+func (r Repository) GetAccountsWithoutUsernameSkeleton(
+	ctx context.Context,
+	after uuid.UUID,
+	limit uint,
+) ([]models.Account, error) {
+	rows, err := r.accountsQ(ctx).
+		FilterUsernameSkeletonMissing().
+		AfterID(after).
+		Page(limit, 0).
+		Select(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to check account existence by username %s, cause: %w", username, err)
+		return nil, fmt.Errorf("failed to select accounts without username skeleton, cause: %w", err)
 	}
 
-	return exist, nil
+	accounts := make([]models.Account, 0, len(rows))
+	for _, row := range rows {
+		accounts = append(accounts, row.ToModel())
+	}
+
+	return accounts, nil
+}
+
+func (r Repository) SetAccountUsernameSkeleton(ctx context.Context, accountID uuid.UUID, skeleton string) error {
+	err := r.accountsQ(ctx).FilterID(accountID).SetUsernameSkeleton(ctx, skeleton)
+	if err != nil {
+		return fmt.Errorf("failed to set username skeleton for account %s, cause: %w", accountID, err)
+	}
+
+	return nil
 }
 
 func (r Repository) GetAccountEmail(ctx context.Context, accountID uuid.UUID) (models.AccountEmail, error) {
@@ -140,7 +276,7 @@ func (r Repository) GetAccountEmail(ctx context.Context, accountID uuid.UUID) (m
 func (r Repository) GetAccountPassword(ctx context.Context, accountID uuid.UUID) (models.AccountPassword, error) {
 	acc, err := r.passwordsQ(ctx).FilterAccountID(accountID).Get(ctx)
 	switch {
-	case errors.Is(err, pgx.ErrNoRows):
+	case errors.Is(err, pgx.ErrNoRows) || (err == nil && !acc.AccountID.Valid):
 		return models.AccountPassword{}, errx.ErrorAccountPasswordNorFound.Raise(
 			fmt.Errorf("account password for account %s not found", accountID),
 		)
@@ -153,14 +289,19 @@ func (r Repository) GetAccountPassword(ctx context.Context, accountID uuid.UUID)
 	return acc.ToModel(), nil
 }
 
+// UpdateAccountPassword sets a new password hash, change tells how it was set.
+// A pending forced change is fulfilled by any new password.
 func (r Repository) UpdateAccountPassword(
 	ctx context.Context,
 	accountID uuid.UUID,
 	passwordHash string,
+	change string,
 ) (models.AccountPassword, error) {
 	acc, err := r.passwordsQ(ctx).
 		FilterAccountID(accountID).
 		UpdateHash(passwordHash).
+		UpdateLastChange(change).
+		UpdateMustChange(false).
 		UpdateOne(ctx)
 	if err != nil {
 		return models.AccountPassword{}, fmt.Errorf(
@@ -171,15 +312,59 @@ func (r Repository) UpdateAccountPassword(
 	return acc.ToModel(), nil
 }
 
+func (r Repository) SetAccountPasswordMustChange(
+	ctx context.Context,
+	accountID uuid.UUID,
+	mustChange bool,
+) (models.AccountPassword, error) {
+	acc, err := r.passwordsQ(ctx).
+		FilterAccountID(accountID).
+		SetMustChange(ctx, mustChange)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return models.AccountPassword{}, errx.ErrorAccountPasswordNorFound.Raise(
+			fmt.Errorf("account password for account %s not found", accountID),
+		)
+	case err != nil:
+		return models.AccountPassword{}, fmt.Errorf(
+			"failed to set must change flag of account password for account %s, cause: %w", accountID, err,
+		)
+	}
+
+	return acc.ToModel(), nil
+}
+
+// RehashAccountPassword does nothing when the password was changed since
+// oldHash was read.
+func (r Repository) RehashAccountPassword(
+	ctx context.Context,
+	accountID uuid.UUID,
+	oldHash, newHash string,
+) error {
+	_, err := r.passwordsQ(ctx).
+		FilterAccountID(accountID).
+		ReplaceHash(ctx, oldHash, newHash)
+	if err != nil {
+		return fmt.Errorf("failed to rehash account password for account %s, cause: %w", accountID, err)
+	}
+
+	return nil
+}
+
 func (r Repository) UpdateAccountUsername(
 	ctx context.Context,
 	accountID uuid.UUID,
-	username string,
+	username, skeleton string,
 ) (models.Account, error) {
 	acc, err := r.accountsQ(ctx).
 		FilterID(accountID).
-		UpdateUsername(username).
+		UpdateUsername(username, skeleton).
 		UpdateOne(ctx)
+	if isUniqueViolation(err, accountUsernameConstraints...) {
+		return models.Account{}, errx.ErrorUsernameAlreadyTaken.Raise(
+			fmt.Errorf("username %s is already taken, cause: %w", username, err),
+		)
+	}
 	if err != nil {
 		return models.Account{}, fmt.Errorf(
 			"failed to update account username for account %s, cause: %w", accountID, err,
@@ -189,6 +374,95 @@ func (r Repository) UpdateAccountUsername(
 	return acc.ToModel(), nil
 }
 
+func (r Repository) UpdateAccountRole(
+	ctx context.Context,
+	accountID uuid.UUID,
+	role string,
+) (models.Account, error) {
+	acc, err := r.accountsQ(ctx).
+		FilterID(accountID).
+		UpdateRole(role).
+		UpdateOne(ctx)
+	if err != nil {
+		return models.Account{}, fmt.Errorf(
+			"failed to update account role for account %s, cause: %w", accountID, err,
+		)
+	}
+
+	return acc.ToModel(), nil
+}
+
+func (r Repository) UpdateAccountStatus(
+	ctx context.Context,
+	accountID uuid.UUID,
+	params account.UpdateAccountStatusParams,
+) (models.Account, error) {
+	acc, err := r.accountsQ(ctx).
+		FilterID(accountID).
+		UpdateStatus(params.Status, params.Reason, params.Until).
+		UpdateOne(ctx)
+	if err != nil {
+		return models.Account{}, fmt.Errorf(
+			"failed to update account status for account %s, cause: %w", accountID, err,
+		)
+	}
+
+	return acc.ToModel(), nil
+}
+
+// ResetExpiredAccountStatuses makes active up to limit accounts whose
+// temporary status ended before the given moment.
+func (r Repository) ResetExpiredAccountStatuses(
+	ctx context.Context,
+	before time.Time,
+	limit uint,
+) ([]models.Account, error) {
+	rows, err := r.accountsQ(ctx).
+		FilterStatusExpired(before).
+		Page(limit, 0).
+		ForUpdateSkipLocked().
+		Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select accounts with expired status, cause: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID.Bytes)
+	}
+
+	updated, err := r.accountsQ(ctx).
+		FilterIDs(ids...).
+		UpdateStatus(models.AccountStatusActive, nil, nil).
+		UpdateReturning(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset status of %d accounts, cause: %w", len(ids), err)
+	}
+
+	accounts := make([]models.Account, 0, len(updated))
+	for _, acc := range updated {
+		accounts = append(accounts, acc.ToModel())
+	}
+
+	return accounts, nil
+}
+
+// TouchAccount bumps updated_at and version of the account, for changes
+// stored outside of the accounts table.
+func (r Repository) TouchAccount(ctx context.Context, accountID uuid.UUID) (models.Account, error) {
+	acc, err := r.accountsQ(ctx).
+		FilterID(accountID).
+		UpdateOne(ctx)
+	if err != nil {
+		return models.Account{}, fmt.Errorf("failed to touch account %s, cause: %w", accountID, err)
+	}
+
+	return acc.ToModel(), nil
+}
+
 func (r Repository) DeleteAccount(ctx context.Context, accountID uuid.UUID) error {
 	err := r.accountsQ(ctx).FilterID(accountID).Delete(ctx)
 	if err != nil {
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/repository/pgdb"
+)
+
+func (r Repository) CreateAccountDeletion(
+	ctx context.Context,
+	accountID uuid.UUID,
+	requestedAt, purgeAfter time.Time,
+) (models.AccountDeletion, error) {
+	row, err := r.accountDeletionsQ(ctx).Insert(ctx, pgdb.InsertAccountDeletionParams{
+		AccountID:   accountID,
+		RequestedAt: requestedAt,
+		PurgeAfter:  purgeAfter,
+	})
+	if err != nil {
+		return models.AccountDeletion{}, fmt.Errorf(
+			"failed to create deletion of account %s, cause: %w", accountID, err,
+		)
+	}
+
+	return row.ToModel(), nil
+}
+
+// GetAccountDeletion returns the pending deletion of the account and holds it
+// until the surrounding transaction ends.
+func (r Repository) GetAccountDeletion(ctx context.Context, accountID uuid.UUID) (models.AccountDeletion, error) {
+	row, err := r.accountDeletionsQ(ctx).
+		FilterAccountID(accountID).
+		ForUpdate().
+		Get(ctx)
+	switch {
+	case err != nil:
+		return models.AccountDeletion{}, fmt.Errorf(
+			"failed to get deletion of account %s, cause: %w", accountID, err,
+		)
+	case !row.AccountID.Valid:
+		return models.AccountDeletion{}, errx.ErrorAccountDeletionNotFound.Raise(
+			fmt.Errorf("account %s is not pending deletion", accountID),
+		)
+	}
+
+	return row.ToModel(), nil
+}
+
+// GetDueAccountDeletions returns up to limit deletions that have to move on at
+// the given moment, except the ones of the skipped accounts. They are not
+// locked, GetAccountDeletion holds each of them while it is handled.
+func (r Repository) GetDueAccountDeletions(
+	ctx context.Context,
+	before time.Time,
+	limit uint,
+	skip ...uuid.UUID,
+) ([]models.AccountDeletion, error) {
+	rows, err := r.accountDeletionsQ(ctx).
+		FilterDue(before).
+		FilterNotAccountIDs(skip...).
+		OrderPurgeAfter().
+		Page(limit, 0).
+		Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select due account deletions, cause: %w", err)
+	}
+
+	deletions := make([]models.AccountDeletion, 0, len(rows))
+	for _, row := range rows {
+		deletions = append(deletions, row.ToModel())
+	}
+
+	return deletions, nil
+}
+
+func (r Repository) UpdateAccountDeletionState(
+	ctx context.Context,
+	accountID uuid.UUID,
+	state string,
+	attempts uint,
+	timeoutAt *time.Time,
+) (models.AccountDeletion, error) {
+	row, err := r.accountDeletionsQ(ctx).
+		FilterAccountID(accountID).
+		UpdateState(state).
+		UpdateAttempts(attempts).
+		UpdateTimeoutAt(timeoutAt).
+		UpdateOne(ctx)
+	if err != nil {
+		return models.AccountDeletion{}, fmt.Errorf(
+			"failed to update deletion state of account %s, cause: %w", accountID, err,
+		)
+	}
+
+	return row.ToModel(), nil
+}
+
+func (r Repository) DeleteAccountDeletion(ctx context.Context, accountID uuid.UUID) error {
+	err := r.accountDeletionsQ(ctx).FilterAccountID(accountID).Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete deletion of account %s, cause: %w", accountID, err)
+	}
+
+	return nil
+}
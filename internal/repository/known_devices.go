@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/repository/pgdb"
+)
+
+func (r Repository) RememberKnownDevice(
+	ctx context.Context,
+	params account.RememberKnownDeviceParams,
+) (models.KnownDevice, bool, error) {
+	row, created, err := r.knownDevicesQ(ctx).Upsert(ctx, pgdb.UpsertKnownDeviceParams{
+		AccountID:   params.AccountID,
+		Fingerprint: params.Fingerprint,
+		IP:          params.IP,
+		OS:          params.OS,
+		Browser:     params.Browser,
+	})
+	if err != nil {
+		return models.KnownDevice{}, false, fmt.Errorf(
+			"failed to remember device for account %s, cause: %w", params.AccountID, err,
+		)
+	}
+
+	return row.ToModel(), created, nil
+}
+
+func (r Repository) ExistsKnownDevicesForAccount(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	exists, err := r.knownDevicesQ(ctx).FilterAccountID(accountID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check known devices of account %s, cause: %w", accountID, err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/netbill/auth-svc/internal/core/models"
+)
+
+func (r Repository) GetLoginFailures(ctx context.Context, scope, key string) (models.LoginFailures, error) {
+	row, err := r.loginFailuresQ(ctx).FilterKey(scope, key).Get(ctx)
+	if err != nil {
+		return models.LoginFailures{}, fmt.Errorf("failed to get login failures for %s %s, cause: %w", scope, key, err)
+	}
+	if !row.Scope.Valid {
+		return models.LoginFailures{Scope: scope, Key: key}, nil
+	}
+
+	return row.ToModel(), nil
+}
+
+func (r Repository) LockLoginFailures(ctx context.Context, scope, key string) error {
+	err := r.loginFailuresQ(ctx).Lock(ctx, scope, key)
+	if err != nil {
+		return fmt.Errorf("failed to lock login failures for %s %s, cause: %w", scope, key, err)
+	}
+
+	return nil
+}
+
+func (r Repository) RegisterLoginFailure(
+	ctx context.Context,
+	scope, key string,
+	at, windowStart time.Time,
+) (models.LoginFailures, error) {
+	row, err := r.loginFailuresQ(ctx).Increment(ctx, scope, key, at, windowStart)
+	if err != nil {
+		return models.LoginFailures{}, fmt.Errorf("failed to register login failure for %s %s, cause: %w", scope, key, err)
+	}
+
+	return row.ToModel(), nil
+}
+
+func (r Repository) LockLogin(ctx context.Context, scope, key string, until time.Time) error {
+	err := r.loginFailuresQ(ctx).FilterKey(scope, key).UpdateLockedUntil(until).Update(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock login for %s %s, cause: %w", scope, key, err)
+	}
+
+	return nil
+}
+
+func (r Repository) DeleteLoginFailures(ctx context.Context, scope, key string) error {
+	_, err := r.loginFailuresQ(ctx).FilterKey(scope, key).Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete login failures for %s %s, cause: %w", scope, key, err)
+	}
+
+	return nil
+}
+
+func (r Repository) DeleteStaleLoginFailures(ctx context.Context, before time.Time) (uint, error) {
+	n, err := r.loginFailuresQ(ctx).FilterStale(before, time.Now().UTC()).Delete(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale login failures, cause: %w", err)
+	}
+
+	return uint(n), nil
+}
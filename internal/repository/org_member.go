@@ -2,25 +2,85 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/organization"
 	"github.com/netbill/auth-svc/internal/repository/pgdb"
 )
 
 func (r Repository) CreateOrgMember(ctx context.Context, member models.Member) error {
-	_, err := r.orgMembersQ(ctx).Insert(ctx, pgdb.OrganizationMemberInsertInput{
+	updatedAt := member.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = member.CreatedAt
+	}
+
+	_, err := r.orgMembersQ(ctx).OnConflictDoNothing().Insert(ctx, pgdb.OrganizationMemberInsertInput{
 		ID:              member.ID,
 		AccountID:       member.AccountID,
 		OrganizationID:  member.OrganizationID,
+		Position:        member.Position,
+		Label:           member.Label,
 		SourceCreatedAt: member.CreatedAt,
+		SourceUpdatedAt: updatedAt,
 	})
-	if err != nil {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// member is already replicated, event was delivered more than once
+		return nil
+	case err != nil:
 		return fmt.Errorf("failed to insert organization member, cause: %w", err)
 	}
 
-	return err
+	return nil
+}
+
+func (r Repository) UpdateOrgMember(ctx context.Context, memberID uuid.UUID, params organization.UpdateMemberParams) error {
+	updated, err := r.orgMembersQ(ctx).
+		FilterByID(memberID).
+		FilterSourceUpdatedBefore(params.UpdatedAt).
+		UpdatePosition(params.Position).
+		UpdateLabel(params.Label).
+		UpdateSourceUpdatedAt(params.UpdatedAt).
+		UpdateMany(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update organization member with id %s, cause: %w", memberID, err)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	exist, err := r.orgMembersQ(ctx).FilterByID(memberID).Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check existence of organization member with id %s, cause: %w", memberID, err)
+	}
+	if !exist {
+		return errx.ErrorOrgMemberNotFound.Raise(
+			fmt.Errorf("organization member with id %s not found", memberID),
+		)
+	}
+
+	// replica already holds the same or a newer version of the member
+	return nil
+}
+
+func (r Repository) GetOrgMember(ctx context.Context, memberID uuid.UUID) (models.Member, error) {
+	row, err := r.orgMembersQ(ctx).FilterByID(memberID).Get(ctx)
+	switch {
+	case err != nil:
+		return models.Member{}, fmt.Errorf("failed to get organization member with id %s, cause: %w", memberID, err)
+	case !row.ID.Valid:
+		return models.Member{}, errx.ErrorOrgMemberNotFound.Raise(
+			fmt.Errorf("organization member with id %s not found", memberID),
+		)
+	}
+
+	return row.ToModel(), nil
 }
 
 func (r Repository) DeleteOrgMember(ctx context.Context, memberID uuid.UUID) error {
@@ -32,11 +92,132 @@ func (r Repository) DeleteOrgMember(ctx context.Context, memberID uuid.UUID) err
 	return nil
 }
 
+func (r Repository) DeleteOrgMembersByOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	err := r.orgMembersQ(ctx).FilterByOrganizationID(organizationID).Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete members of organization %s, cause: %w", organizationID, err)
+	}
+
+	return nil
+}
+
+func (r Repository) CreateOrgMemberTombstone(ctx context.Context, memberID uuid.UUID, deletedAt time.Time) error {
+	err := r.memberTombstonesQ(ctx).InsertMember(ctx, memberID, deletedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create tombstone for organization member %s, cause: %w", memberID, err)
+	}
+
+	return nil
+}
+
+func (r Repository) CreateOrganizationTombstone(ctx context.Context, organizationID uuid.UUID, deletedAt time.Time) error {
+	err := r.memberTombstonesQ(ctx).InsertOrganization(ctx, organizationID, deletedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create tombstone for organization %s, cause: %w", organizationID, err)
+	}
+
+	return nil
+}
+
+func (r Repository) ExistsOrgMemberTombstone(ctx context.Context, memberID uuid.UUID) (bool, error) {
+	exists, err := r.memberTombstonesQ(ctx).ExistsMember(ctx, memberID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check tombstone of organization member %s, cause: %w", memberID, err)
+	}
+
+	return exists, nil
+}
+
+func (r Repository) ExistsOrganizationTombstone(ctx context.Context, organizationID uuid.UUID) (bool, error) {
+	exists, err := r.memberTombstonesQ(ctx).ExistsOrganization(ctx, organizationID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check tombstone of organization %s, cause: %w", organizationID, err)
+	}
+
+	return exists, nil
+}
+
+func (r Repository) DeleteOrgMemberTombstonesBefore(ctx context.Context, before time.Time, limit uint) (uint, error) {
+	n, err := r.memberTombstonesQ(ctx).DeleteMembersBefore(ctx, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete organization member tombstones left before %s, cause: %w", before, err)
+	}
+
+	return n, nil
+}
+
+func (r Repository) DeleteOrganizationTombstonesBefore(ctx context.Context, before time.Time, limit uint) (uint, error) {
+	n, err := r.memberTombstonesQ(ctx).DeleteOrganizationsBefore(ctx, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete organization tombstones left before %s, cause: %w", before, err)
+	}
+
+	return n, nil
+}
+
+// GetOrgMembersUpdatedBefore returns members whose last replicated version
+// is older than the given time.
+func (r Repository) GetOrgMembersUpdatedBefore(ctx context.Context, before time.Time) ([]models.Member, error) {
+	rows, err := r.orgMembersQ(ctx).FilterSourceUpdatedBefore(before).Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select organization members updated before %s, cause: %w", before, err)
+	}
+
+	members := make([]models.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, row.ToModel())
+	}
+
+	return members, nil
+}
+
 func (r Repository) ExistOrgMemberByAccount(ctx context.Context, accountID uuid.UUID) (bool, error) {
-	exist, err := r.orgMembersQ(ctx).FilterByID(accountID).Exists(ctx)
+	exist, err := r.orgMembersQ(ctx).FilterByAccountID(accountID).Exists(ctx)
 	if err != nil {
 		return false, fmt.Errorf("failed to check existence of organization member with account id %s, cause: %w", accountID, err)
 	}
 
 	return exist, nil
 }
+
+func (r Repository) ExistOrgMember(ctx context.Context, accountID, organizationID uuid.UUID) (bool, error) {
+	exist, err := r.orgMembersQ(ctx).
+		FilterByAccountID(accountID).
+		FilterByOrganizationID(organizationID).
+		Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to check membership of account %s in organization %s, cause: %w", accountID, organizationID, err,
+		)
+	}
+
+	return exist, nil
+}
+
+func (r Repository) GetOrgMembersByAccount(ctx context.Context, accountID uuid.UUID) ([]models.Member, error) {
+	rows, err := r.orgMembersQ(ctx).FilterByAccountID(accountID).Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select organization members for account %s, cause: %w", accountID, err)
+	}
+
+	members := make([]models.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, row.ToModel())
+	}
+
+	return members, nil
+}
+
+func (r Repository) GetOrgMembersByOrganization(ctx context.Context, organizationID uuid.UUID) ([]models.Member, error) {
+	rows, err := r.orgMembersQ(ctx).FilterByOrganizationID(organizationID).Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select members of organization %s, cause: %w", organizationID, err)
+	}
+
+	members := make([]models.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, row.ToModel())
+	}
+
+	return members, nil
+}
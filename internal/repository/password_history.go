@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// GetPasswordHistory returns up to limit hashes of previous passwords of the
+// account, the most recent first.
+func (r Repository) GetPasswordHistory(ctx context.Context, accountID uuid.UUID, limit uint) ([]string, error) {
+	rows, err := r.passwordHistoryQ(ctx).
+		FilterAccountID(accountID).
+		OrderCreatedAt(false).
+		Limit(limit).
+		Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password history for account %s, cause: %w", accountID, err)
+	}
+
+	hashes := make([]string, 0, len(rows))
+	for _, row := range rows {
+		hashes = append(hashes, row.Hash.String)
+	}
+
+	return hashes, nil
+}
+
+// AddPasswordHistory remembers a replaced password hash and forgets all but
+// the keep most recent ones.
+func (r Repository) AddPasswordHistory(ctx context.Context, accountID uuid.UUID, hash string, keep uint) error {
+	q := r.passwordHistoryQ(ctx)
+
+	if keep > 0 {
+		err := q.Insert(ctx, accountID, hash)
+		if err != nil {
+			return fmt.Errorf("failed to add password history for account %s, cause: %w", accountID, err)
+		}
+	}
+
+	err := q.DeleteExceptNewest(ctx, accountID, keep)
+	if err != nil {
+		return fmt.Errorf("failed to trim password history for account %s, cause: %w", accountID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/netbill/pgxtx"
+)
+
+const accountDeletionsTable = "account_deletions"
+
+const accountDeletionsColumns = "account_id, state, attempts, requested_at, purge_after, timeout_at, updated_at"
+
+type AccountDeletion struct {
+	AccountID   pgtype.UUID        `db:"account_id"`
+	State       pgtype.Text        `db:"state"`
+	Attempts    pgtype.Int4        `db:"attempts"`
+	RequestedAt pgtype.Timestamptz `db:"requested_at"`
+	PurgeAfter  pgtype.Timestamptz `db:"purge_after"`
+	TimeoutAt   pgtype.Timestamptz `db:"timeout_at"`
+	UpdatedAt   pgtype.Timestamptz `db:"updated_at"`
+}
+
+func (d *AccountDeletion) scan(row sq.RowScanner) error {
+	err := row.Scan(
+		&d.AccountID,
+		&d.State,
+		&d.Attempts,
+		&d.RequestedAt,
+		&d.PurgeAfter,
+		&d.TimeoutAt,
+		&d.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("scanning account deletion: %w", err)
+	}
+	return nil
+}
+
+type AccountDeletionsQ struct {
+	db       pgxtx.DBTX
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+}
+
+func NewAccountDeletionsQ(db pgxtx.DBTX) AccountDeletionsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return AccountDeletionsQ{
+		db:       db,
+		selector: builder.Select(accountDeletionsColumns).From(accountDeletionsTable),
+		inserter: builder.Insert(accountDeletionsTable),
+		updater:  builder.Update(accountDeletionsTable),
+		deleter:  builder.Delete(accountDeletionsTable),
+	}
+}
+
+type InsertAccountDeletionParams struct {
+	AccountID   uuid.UUID
+	RequestedAt time.Time
+	PurgeAfter  time.Time
+}
+
+func (q AccountDeletionsQ) Insert(ctx context.Context, input InsertAccountDeletionParams) (AccountDeletion, error) {
+	query, args, err := q.inserter.SetMap(map[string]interface{}{
+		"account_id":   pgtype.UUID{Bytes: [16]byte(input.AccountID), Valid: true},
+		"requested_at": pgtype.Timestamptz{Time: input.RequestedAt.UTC(), Valid: true},
+		"purge_after":  pgtype.Timestamptz{Time: input.PurgeAfter.UTC(), Valid: true},
+	}).Suffix("RETURNING " + accountDeletionsColumns).ToSql()
+	if err != nil {
+		return AccountDeletion{}, fmt.Errorf("building insert query for %s: %w", accountDeletionsTable, err)
+	}
+
+	var out AccountDeletion
+	if err = out.scan(q.db.QueryRow(ctx, query, args...)); err != nil {
+		return AccountDeletion{}, err
+	}
+	return out, nil
+}
+
+func (q AccountDeletionsQ) Get(ctx context.Context) (AccountDeletion, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return AccountDeletion{}, fmt.Errorf("building get query for %s: %w", accountDeletionsTable, err)
+	}
+
+	var d AccountDeletion
+	err = d.scan(q.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return AccountDeletion{}, nil
+		}
+		return AccountDeletion{}, err
+	}
+
+	return d, nil
+}
+
+func (q AccountDeletionsQ) Select(ctx context.Context) ([]AccountDeletion, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", accountDeletionsTable, err)
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]AccountDeletion, 0)
+	for rows.Next() {
+		var d AccountDeletion
+		if err = d.scan(rows); err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (q AccountDeletionsQ) UpdateOne(ctx context.Context) (AccountDeletion, error) {
+	query, args, err := q.updater.
+		Set("updated_at", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}).
+		Suffix("RETURNING " + accountDeletionsColumns).
+		ToSql()
+	if err != nil {
+		return AccountDeletion{}, fmt.Errorf("building update query for %s: %w", accountDeletionsTable, err)
+	}
+
+	var updated AccountDeletion
+	if err = updated.scan(q.db.QueryRow(ctx, query, args...)); err != nil {
+		return AccountDeletion{}, err
+	}
+
+	return updated, nil
+}
+
+func (q AccountDeletionsQ) UpdateState(state string) AccountDeletionsQ {
+	q.updater = q.updater.Set("state", pgtype.Text{String: state, Valid: true})
+	return q
+}
+
+func (q AccountDeletionsQ) UpdateAttempts(attempts uint) AccountDeletionsQ {
+	q.updater = q.updater.Set("attempts", pgtype.Int4{Int32: int32(attempts), Valid: true})
+	return q
+}
+
+func (q AccountDeletionsQ) UpdateTimeoutAt(t *time.Time) AccountDeletionsQ {
+	q.updater = q.updater.Set("timeout_at", nullableTimestamptz(t))
+	return q
+}
+
+func (q AccountDeletionsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", accountDeletionsTable, err)
+	}
+
+	_, err = q.db.Exec(ctx, query, args...)
+	return err
+}
+
+func (q AccountDeletionsQ) FilterAccountID(accountID uuid.UUID) AccountDeletionsQ {
+	val := pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}
+
+	q.selector = q.selector.Where(sq.Eq{"account_id": val})
+	q.updater = q.updater.Where(sq.Eq{"account_id": val})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": val})
+	return q
+}
+
+// FilterNotAccountIDs leaves out deletions of the given accounts.
+func (q AccountDeletionsQ) FilterNotAccountIDs(accountIDs ...uuid.UUID) AccountDeletionsQ {
+	if len(accountIDs) == 0 {
+		return q
+	}
+
+	vals := make([]pgtype.UUID, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		vals = append(vals, pgtype.UUID{Bytes: [16]byte(id), Valid: true})
+	}
+
+	q.selector = q.selector.Where(sq.NotEq{"account_id": vals})
+	return q
+}
+
+// FilterDue keeps deletions that have to move on at t: scheduled ones whose
+// grace period is over and ones that stopped waiting for memberships removal.
+func (q AccountDeletionsQ) FilterDue(t time.Time) AccountDeletionsQ {
+	val := pgtype.Timestamptz{Time: t.UTC(), Valid: true}
+
+	q.selector = q.selector.Where(sq.Or{
+		sq.And{
+			sq.Eq{"state": pgtype.Text{String: "scheduled", Valid: true}},
+			sq.LtOrEq{"purge_after": val},
+		},
+		sq.And{
+			sq.Eq{"state": pgtype.Text{String: "awaiting_memberships", Valid: true}},
+			sq.LtOrEq{"timeout_at": val},
+		},
+	})
+	return q
+}
+
+func (q AccountDeletionsQ) OrderPurgeAfter() AccountDeletionsQ {
+	q.selector = q.selector.OrderBy("purge_after ASC", "account_id ASC")
+	return q
+}
+
+func (q AccountDeletionsQ) Page(limit, offset uint) AccountDeletionsQ {
+	q.selector = q.selector.Limit(uint64(limit)).Offset(uint64(offset))
+	return q
+}
+
+func (q AccountDeletionsQ) ForUpdate() AccountDeletionsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+func (q AccountDeletionsQ) ForUpdateSkipLocked() AccountDeletionsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE SKIP LOCKED")
+	return q
+}
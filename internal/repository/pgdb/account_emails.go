@@ -167,6 +167,7 @@ func (q AccountEmailsQ) Select(ctx context.Context) ([]AccountEmail, error) {
 
 func (q AccountEmailsQ) Exists(ctx context.Context) (bool, error) {
 	query, args, err := q.selector.
+		RemoveColumns().
 		Columns("1").
 		Limit(1).
 		ToSql()
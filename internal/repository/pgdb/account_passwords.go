@@ -15,19 +15,23 @@ import (
 
 const accountPasswordsTable = "account_passwords"
 
-const accountPasswordsColumns = "account_id, hash, created_at, updated_at"
+const accountPasswordsColumns = "account_id, hash, must_change, last_change, created_at, updated_at"
 
 type AccountPassword struct {
-	AccountID pgtype.UUID        `db:"account_id"`
-	Hash      pgtype.Text        `db:"hash"`
-	UpdatedAt pgtype.Timestamptz `db:"updated_at"`
-	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	AccountID  pgtype.UUID        `db:"account_id"`
+	Hash       pgtype.Text        `db:"hash"`
+	MustChange pgtype.Bool        `db:"must_change"`
+	LastChange pgtype.Text        `db:"last_change"`
+	UpdatedAt  pgtype.Timestamptz `db:"updated_at"`
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
 }
 
 func (a *AccountPassword) scan(row sq.RowScanner) error {
 	err := row.Scan(
 		&a.AccountID,
 		&a.Hash,
+		&a.MustChange,
+		&a.LastChange,
 		&a.CreatedAt,
 		&a.UpdatedAt,
 	)
@@ -50,7 +54,7 @@ func NewAccountPasswordsQ(db pgxtx.DBTX) AccountPasswordsQ {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	return AccountPasswordsQ{
 		db:       db,
-		selector: builder.Select(accountPasswordsTable + ".*").From(accountPasswordsTable),
+		selector: builder.Select(accountPasswordsColumns).From(accountPasswordsTable),
 		inserter: builder.Insert(accountPasswordsTable),
 		updater:  builder.Update(accountPasswordsTable),
 		deleter:  builder.Delete(accountPasswordsTable),
@@ -60,10 +64,12 @@ func NewAccountPasswordsQ(db pgxtx.DBTX) AccountPasswordsQ {
 
 func (q AccountPasswordsQ) Insert(ctx context.Context, input AccountPassword) (AccountPassword, error) {
 	query, args, err := q.inserter.SetMap(map[string]interface{}{
-		"account_id": input.AccountID,
-		"hash":       input.Hash,
-		"updated_at": input.UpdatedAt,
-		"created_at": input.CreatedAt,
+		"account_id":  input.AccountID,
+		"hash":        input.Hash,
+		"must_change": input.MustChange,
+		"last_change": input.LastChange,
+		"updated_at":  input.UpdatedAt,
+		"created_at":  input.CreatedAt,
 	}).Suffix("RETURNING " + accountPasswordsColumns).ToSql()
 	if err != nil {
 		return AccountPassword{}, fmt.Errorf("building insert query for %s: %w", accountPasswordsTable, err)
@@ -111,11 +117,60 @@ func (q AccountPasswordsQ) UpdateOne(ctx context.Context) (AccountPassword, erro
 	return updated, nil
 }
 
+// ReplaceHash swaps oldHash for newHash in place, leaving updated_at alone
+// since it tracks password changes made by the owner. It reports whether the
+// hash was still oldHash.
+func (q AccountPasswordsQ) ReplaceHash(ctx context.Context, oldHash, newHash string) (bool, error) {
+	query, args, err := q.updater.
+		Set("hash", pgtype.Text{String: newHash, Valid: true}).
+		Where(sq.Eq{"hash": pgtype.Text{String: oldHash, Valid: true}}).
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("building replace hash query for %s: %w", accountPasswordsTable, err)
+	}
+
+	tag, err := q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("executing replace hash query for %s: %w", accountPasswordsTable, err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
 func (q AccountPasswordsQ) UpdateHash(hash string) AccountPasswordsQ {
 	q.updater = q.updater.Set("hash", pgtype.Text{String: hash, Valid: true})
 	return q
 }
 
+func (q AccountPasswordsQ) UpdateLastChange(change string) AccountPasswordsQ {
+	q.updater = q.updater.Set("last_change", pgtype.Text{String: change, Valid: true})
+	return q
+}
+
+func (q AccountPasswordsQ) UpdateMustChange(mustChange bool) AccountPasswordsQ {
+	q.updater = q.updater.Set("must_change", pgtype.Bool{Bool: mustChange, Valid: true})
+	return q
+}
+
+// SetMustChange flags the password as one to be changed on the next login,
+// updated_at is left alone since it tracks changes of the password itself.
+func (q AccountPasswordsQ) SetMustChange(ctx context.Context, mustChange bool) (AccountPassword, error) {
+	query, args, err := q.updater.
+		Set("must_change", pgtype.Bool{Bool: mustChange, Valid: true}).
+		Suffix("RETURNING " + accountPasswordsColumns).
+		ToSql()
+	if err != nil {
+		return AccountPassword{}, fmt.Errorf("building update query for %s: %w", accountPasswordsTable, err)
+	}
+
+	var updated AccountPassword
+	if err = updated.scan(q.db.QueryRow(ctx, query, args...)); err != nil {
+		return AccountPassword{}, err
+	}
+
+	return updated, nil
+}
+
 func (q AccountPasswordsQ) Get(ctx context.Context) (AccountPassword, error) {
 	query, args, err := q.selector.Limit(1).ToSql()
 	if err != nil {
@@ -165,6 +220,7 @@ func (q AccountPasswordsQ) Select(ctx context.Context) ([]AccountPassword, error
 
 func (q AccountPasswordsQ) Exists(ctx context.Context) (bool, error) {
 	query, args, err := q.selector.
+		RemoveColumns().
 		Columns("1").
 		Limit(1).
 		ToSql()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -15,15 +16,20 @@ import (
 
 const accountsTable = "accounts"
 
-const accountsColumns = "id, username, role, created_at, updated_at"
-const accountsColumnsA = "a.id, a.username, a.role, a.created_at, a.updated_at"
+const accountsColumns = "id, username, role, status, status_reason, status_until, version, created_at, updated_at"
+const accountsColumnsA = "a.id, a.username, a.role, a.status, a.status_reason, a.status_until, a.version, a.created_at, a.updated_at"
+const accountsColumnsQ = "accounts.id, accounts.username, accounts.role, accounts.status, accounts.status_reason, accounts.status_until, accounts.version, accounts.created_at, accounts.updated_at"
 
 type Account struct {
-	ID        pgtype.UUID        `db:"id"`
-	Username  pgtype.Text        `db:"username"`
-	Role      pgtype.Text        `db:"role"`
-	CreatedAt pgtype.Timestamptz `db:"created_at"`
-	UpdatedAt pgtype.Timestamptz `db:"updated_at"`
+	ID           pgtype.UUID        `db:"id"`
+	Username     pgtype.Text        `db:"username"`
+	Role         pgtype.Text        `db:"role"`
+	Status       pgtype.Text        `db:"status"`
+	StatusReason pgtype.Text        `db:"status_reason"`
+	StatusUntil  pgtype.Timestamptz `db:"status_until"`
+	Version      pgtype.Int8        `db:"version"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at"`
 }
 
 func (a *Account) scan(row sq.RowScanner) error {
@@ -31,6 +37,10 @@ func (a *Account) scan(row sq.RowScanner) error {
 		&a.ID,
 		&a.Username,
 		&a.Role,
+		&a.Status,
+		&a.StatusReason,
+		&a.StatusUntil,
+		&a.Version,
 		&a.CreatedAt,
 		&a.UpdatedAt,
 	)
@@ -53,7 +63,7 @@ func NewAccountsQ(db pgxtx.DBTX) AccountsQ {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	return AccountsQ{
 		db:       db,
-		selector: builder.Select(accountsTable + ".*").From(accountsTable),
+		selector: builder.Select(accountsColumnsQ).From(accountsTable),
 		inserter: builder.Insert(accountsTable),
 		updater:  builder.Update(accountsTable),
 		deleter:  builder.Delete(accountsTable),
@@ -62,17 +72,19 @@ func NewAccountsQ(db pgxtx.DBTX) AccountsQ {
 }
 
 type InsertAccountParams struct {
-	ID       uuid.UUID
-	Username string
-	Role     string
+	ID               uuid.UUID
+	Username         string
+	UsernameSkeleton string
+	Role             string
 }
 
 func (q AccountsQ) Insert(ctx context.Context, input InsertAccountParams) (Account, error) {
 	query, args, err := q.inserter.SetMap(map[string]interface{}{
-		"id":       pgtype.UUID{Bytes: input.ID, Valid: true},
-		"username": pgtype.Text{String: input.Username, Valid: true},
-		"role":     pgtype.Text{String: input.Role, Valid: true},
-	}).Suffix("RETURNING " + accountsTable + ".*").ToSql()
+		"id":                pgtype.UUID{Bytes: input.ID, Valid: true},
+		"username":          pgtype.Text{String: input.Username, Valid: true},
+		"username_skeleton": pgtype.Text{String: input.UsernameSkeleton, Valid: true},
+		"role":              pgtype.Text{String: input.Role, Valid: true},
+	}).Suffix("RETURNING " + accountsColumns).ToSql()
 	if err != nil {
 		return Account{}, fmt.Errorf("building insert query for %s: %w", accountsTable, err)
 	}
@@ -133,6 +145,7 @@ func (q AccountsQ) Select(ctx context.Context) ([]Account, error) {
 
 func (q AccountsQ) Exists(ctx context.Context) (bool, error) {
 	query, args, err := q.selector.
+		RemoveColumns().
 		Columns("1").
 		Limit(1).
 		ToSql()
@@ -153,7 +166,9 @@ func (q AccountsQ) Exists(ctx context.Context) (bool, error) {
 }
 
 func (q AccountsQ) UpdateMany(ctx context.Context) (int64, error) {
-	q.updater = q.updater.Set("updated_at", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true})
+	q.updater = q.updater.
+		Set("updated_at", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}).
+		Set("version", sq.Expr("version + 1"))
 
 	query, args, err := q.updater.ToSql()
 	if err != nil {
@@ -169,7 +184,9 @@ func (q AccountsQ) UpdateMany(ctx context.Context) (int64, error) {
 }
 
 func (q AccountsQ) UpdateOne(ctx context.Context) (Account, error) {
-	q.updater = q.updater.Set("updated_at", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true})
+	q.updater = q.updater.
+		Set("updated_at", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}).
+		Set("version", sq.Expr("version + 1"))
 
 	query, args, err := q.updater.
 		Suffix("RETURNING " + accountsColumns).
@@ -186,16 +203,74 @@ func (q AccountsQ) UpdateOne(ctx context.Context) (Account, error) {
 	return updated, nil
 }
 
+func (q AccountsQ) UpdateReturning(ctx context.Context) ([]Account, error) {
+	q.updater = q.updater.
+		Set("updated_at", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}).
+		Set("version", sq.Expr("version + 1"))
+
+	query, args, err := q.updater.
+		Suffix("RETURNING " + accountsColumns).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building update query for %s: %w", accountsTable, err)
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("executing update query for %s: %w", accountsTable, err)
+	}
+	defer rows.Close()
+
+	out := make([]Account, 0)
+	for rows.Next() {
+		var a Account
+		if err = a.scan(rows); err != nil {
+			return nil, err
+		}
+		out = append(out, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 func (q AccountsQ) UpdateRole(role string) AccountsQ {
 	q.updater = q.updater.Set("role", pgtype.Text{String: role, Valid: true})
 	return q
 }
 
-func (q AccountsQ) UpdateUsername(username string) AccountsQ {
-	q.updater = q.updater.Set("username", pgtype.Text{String: username, Valid: true})
+func (q AccountsQ) UpdateStatus(status string, reason *string, until *time.Time) AccountsQ {
+	q.updater = q.updater.
+		Set("status", pgtype.Text{String: status, Valid: true}).
+		Set("status_reason", nullableText(reason)).
+		Set("status_until", nullableTimestamptz(until))
+	return q
+}
+
+func (q AccountsQ) UpdateUsername(username, skeleton string) AccountsQ {
+	q.updater = q.updater.
+		Set("username", pgtype.Text{String: username, Valid: true}).
+		Set("username_skeleton", pgtype.Text{String: skeleton, Valid: true})
 	return q
 }
 
+// SetUsernameSkeleton stores the skeleton of an unchanged username, the
+// account itself is not considered updated.
+func (q AccountsQ) SetUsernameSkeleton(ctx context.Context, skeleton string) error {
+	query, args, err := q.updater.
+		Set("username_skeleton", pgtype.Text{String: skeleton, Valid: true}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building update query for %s: %w", accountsTable, err)
+	}
+
+	_, err = q.db.Exec(ctx, query, args...)
+	return err
+}
+
 func (q AccountsQ) Delete(ctx context.Context) error {
 	query, args, err := q.deleter.ToSql()
 	if err != nil {
@@ -216,6 +291,19 @@ func (q AccountsQ) FilterID(id uuid.UUID) AccountsQ {
 	return q
 }
 
+func (q AccountsQ) FilterIDs(ids ...uuid.UUID) AccountsQ {
+	pids := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		pids = append(pids, pgtype.UUID{Bytes: [16]byte(id), Valid: true})
+	}
+
+	q.selector = q.selector.Where(sq.Eq{"accounts.id": pids})
+	q.counter = q.counter.Where(sq.Eq{"accounts.id": pids})
+	q.deleter = q.deleter.Where(sq.Eq{"id": pids})
+	q.updater = q.updater.Where(sq.Eq{"id": pids})
+	return q
+}
+
 func (q AccountsQ) FilterRole(role string) AccountsQ {
 	val := pgtype.Text{String: role, Valid: true}
 
@@ -226,6 +314,27 @@ func (q AccountsQ) FilterRole(role string) AccountsQ {
 	return q
 }
 
+func (q AccountsQ) FilterStatus(status string) AccountsQ {
+	val := pgtype.Text{String: status, Valid: true}
+
+	q.selector = q.selector.Where(sq.Eq{"accounts.status": val})
+	q.counter = q.counter.Where(sq.Eq{"accounts.status": val})
+	q.deleter = q.deleter.Where(sq.Eq{"status": val})
+	q.updater = q.updater.Where(sq.Eq{"status": val})
+	return q
+}
+
+// FilterStatusExpired keeps accounts whose temporary status ended before t.
+func (q AccountsQ) FilterStatusExpired(t time.Time) AccountsQ {
+	cond := sq.Lt{"accounts.status_until": pgtype.Timestamptz{Time: t.UTC(), Valid: true}}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
 func (q AccountsQ) FilterUsername(username string) AccountsQ {
 	val := pgtype.Text{String: username, Valid: true}
 
@@ -236,6 +345,34 @@ func (q AccountsQ) FilterUsername(username string) AccountsQ {
 	return q
 }
 
+func (q AccountsQ) FilterUsernameFold(username string) AccountsQ {
+	val := pgtype.Text{String: username, Valid: true}
+
+	q.selector = q.selector.Where(sq.Expr("lower(accounts.username) = lower(?)", val))
+	q.counter = q.counter.Where(sq.Expr("lower(accounts.username) = lower(?)", val))
+	q.deleter = q.deleter.Where(sq.Expr("lower(username) = lower(?)", val))
+	q.updater = q.updater.Where(sq.Expr("lower(username) = lower(?)", val))
+	return q
+}
+
+func (q AccountsQ) FilterUsernameSkeleton(skeleton string) AccountsQ {
+	val := pgtype.Text{String: skeleton, Valid: true}
+
+	q.selector = q.selector.Where(sq.Eq{"accounts.username_skeleton": val})
+	q.counter = q.counter.Where(sq.Eq{"accounts.username_skeleton": val})
+	q.deleter = q.deleter.Where(sq.Eq{"username_skeleton": val})
+	q.updater = q.updater.Where(sq.Eq{"username_skeleton": val})
+	return q
+}
+
+func (q AccountsQ) FilterUsernameSkeletonMissing() AccountsQ {
+	q.selector = q.selector.Where(sq.Eq{"accounts.username_skeleton": nil})
+	q.counter = q.counter.Where(sq.Eq{"accounts.username_skeleton": nil})
+	q.deleter = q.deleter.Where(sq.Eq{"username_skeleton": nil})
+	q.updater = q.updater.Where(sq.Eq{"username_skeleton": nil})
+	return q
+}
+
 func (q AccountsQ) FilterEmail(email string) AccountsQ {
 	em := pgtype.Text{String: email, Valid: true}
 
@@ -257,6 +394,54 @@ func (q AccountsQ) FilterEmail(email string) AccountsQ {
 	return q
 }
 
+func (q AccountsQ) FilterUsernamePrefix(prefix string) AccountsQ {
+	cond := sq.ILike{"accounts.username": escapeLike(prefix) + "%"}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+func (q AccountsQ) FilterCreatedAfter(t time.Time) AccountsQ {
+	cond := sq.GtOrEq{"accounts.created_at": pgtype.Timestamptz{Time: t.UTC(), Valid: true}}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+func (q AccountsQ) FilterCreatedBefore(t time.Time) AccountsQ {
+	cond := sq.Lt{"accounts.created_at": pgtype.Timestamptz{Time: t.UTC(), Valid: true}}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	return q
+}
+
+func (q AccountsQ) OrderCreatedAt(ascending bool) AccountsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("accounts.created_at ASC", "accounts.id ASC")
+	} else {
+		q.selector = q.selector.OrderBy("accounts.created_at DESC", "accounts.id DESC")
+	}
+	return q
+}
+
+// AfterID keeps accounts ordered after the given one, for walking the
+// table in batches.
+func (q AccountsQ) AfterID(id uuid.UUID) AccountsQ {
+	q.selector = q.selector.
+		Where(sq.Gt{"accounts.id": pgtype.UUID{Bytes: [16]byte(id), Valid: true}}).
+		OrderBy("accounts.id ASC")
+	return q
+}
+
 func (q AccountsQ) Count(ctx context.Context) (uint, error) {
 	query, args, err := q.counter.ToSql()
 	if err != nil {
@@ -279,3 +464,17 @@ func (q AccountsQ) Page(limit, offset uint) AccountsQ {
 	q.selector = q.selector.Limit(uint64(limit)).Offset(uint64(offset))
 	return q
 }
+
+func (q AccountsQ) ForUpdate() AccountsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+func (q AccountsQ) ForUpdateSkipLocked() AccountsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE SKIP LOCKED")
+	return q
+}
+
+func escapeLike(val string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(val)
+}
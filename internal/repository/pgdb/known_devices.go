@@ -0,0 +1,120 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/netbill/pgxtx"
+)
+
+const knownDevicesTable = "account_known_devices"
+
+const knownDevicesColumns = "account_id, fingerprint, ip, os, browser, first_seen_at, last_seen_at"
+
+type KnownDevice struct {
+	AccountID   pgtype.UUID        `db:"account_id"`
+	Fingerprint pgtype.Text        `db:"fingerprint"`
+	IP          pgtype.Text        `db:"ip"`
+	OS          pgtype.Text        `db:"os"`
+	Browser     pgtype.Text        `db:"browser"`
+	FirstSeenAt pgtype.Timestamptz `db:"first_seen_at"`
+	LastSeenAt  pgtype.Timestamptz `db:"last_seen_at"`
+}
+
+func (d *KnownDevice) scan(row sq.RowScanner, dest ...any) error {
+	err := row.Scan(append([]any{
+		&d.AccountID,
+		&d.Fingerprint,
+		&d.IP,
+		&d.OS,
+		&d.Browser,
+		&d.FirstSeenAt,
+		&d.LastSeenAt,
+	}, dest...)...)
+	if err != nil {
+		return fmt.Errorf("scanning known device: %w", err)
+	}
+	return nil
+}
+
+type KnownDevicesQ struct {
+	db       pgxtx.DBTX
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+}
+
+func NewKnownDevicesQ(db pgxtx.DBTX) KnownDevicesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return KnownDevicesQ{
+		db:       db,
+		selector: builder.Select(knownDevicesColumns).From(knownDevicesTable),
+		inserter: builder.Insert(knownDevicesTable),
+	}
+}
+
+type UpsertKnownDeviceParams struct {
+	AccountID   uuid.UUID
+	Fingerprint string
+	IP          string
+	OS          string
+	Browser     string
+}
+
+// Upsert remembers the device or refreshes its last sighting, created reports
+// whether the device was seen for the first time.
+func (q KnownDevicesQ) Upsert(ctx context.Context, input UpsertKnownDeviceParams) (out KnownDevice, created bool, err error) {
+	query, args, err := q.inserter.SetMap(map[string]interface{}{
+		"account_id":  pgtype.UUID{Bytes: [16]byte(input.AccountID), Valid: true},
+		"fingerprint": pgtype.Text{String: input.Fingerprint, Valid: true},
+		"ip":          optionalText(input.IP),
+		"os":          optionalText(input.OS),
+		"browser":     optionalText(input.Browser),
+	}).Suffix(
+		`ON CONFLICT (account_id, fingerprint) DO UPDATE SET
+			ip = EXCLUDED.ip,
+			last_seen_at = now()
+		RETURNING ` + knownDevicesColumns + `, (xmax = 0) AS created`,
+	).ToSql()
+	if err != nil {
+		return KnownDevice{}, false, fmt.Errorf("building upsert query for %s: %w", knownDevicesTable, err)
+	}
+
+	if err = out.scan(q.db.QueryRow(ctx, query, args...), &created); err != nil {
+		return KnownDevice{}, false, err
+	}
+	return out, created, nil
+}
+
+func (q KnownDevicesQ) Exists(ctx context.Context) (bool, error) {
+	query, args, err := q.selector.
+		RemoveColumns().
+		Columns("1").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var one int
+	err = q.db.QueryRow(ctx, query, args...).Scan(&one)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (q KnownDevicesQ) FilterAccountID(accountID uuid.UUID) KnownDevicesQ {
+	id := pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}
+
+	q.selector = q.selector.Where(sq.Eq{"account_id": id})
+	return q
+}
@@ -0,0 +1,186 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/netbill/pgxtx"
+)
+
+const loginFailuresTable = "login_failures"
+
+const loginFailuresColumns = "scope, key, failures, last_failure_at, locked_until"
+
+type LoginFailure struct {
+	Scope         pgtype.Text        `db:"scope"`
+	Key           pgtype.Text        `db:"key"`
+	Failures      pgtype.Int4        `db:"failures"`
+	LastFailureAt pgtype.Timestamptz `db:"last_failure_at"`
+	LockedUntil   pgtype.Timestamptz `db:"locked_until"`
+}
+
+func (f *LoginFailure) scan(row sq.RowScanner) error {
+	err := row.Scan(
+		&f.Scope,
+		&f.Key,
+		&f.Failures,
+		&f.LastFailureAt,
+		&f.LockedUntil,
+	)
+	if err != nil {
+		return fmt.Errorf("scanning login failure: %w", err)
+	}
+	return nil
+}
+
+type LoginFailuresQ struct {
+	db       pgxtx.DBTX
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+}
+
+func NewLoginFailuresQ(db pgxtx.DBTX) LoginFailuresQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return LoginFailuresQ{
+		db:       db,
+		selector: builder.Select(loginFailuresColumns).From(loginFailuresTable),
+		inserter: builder.Insert(loginFailuresTable),
+		updater:  builder.Update(loginFailuresTable),
+		deleter:  builder.Delete(loginFailuresTable),
+	}
+}
+
+// Increment counts one more failure for the key, the counter starts over when
+// the previous failure happened before windowStart.
+func (q LoginFailuresQ) Increment(
+	ctx context.Context,
+	scope, key string,
+	at, windowStart time.Time,
+) (LoginFailure, error) {
+	query, args, err := q.inserter.
+		Columns("scope", "key", "failures", "last_failure_at").
+		Values(
+			pgtype.Text{String: scope, Valid: true},
+			pgtype.Text{String: key, Valid: true},
+			1,
+			pgtype.Timestamptz{Time: at.UTC(), Valid: true},
+		).
+		Suffix(
+			`ON CONFLICT (scope, key) DO UPDATE SET
+				failures = CASE
+					WHEN `+loginFailuresTable+`.last_failure_at < ? THEN 1
+					ELSE `+loginFailuresTable+`.failures + 1
+				END,
+				last_failure_at = EXCLUDED.last_failure_at
+			RETURNING `+loginFailuresColumns,
+			pgtype.Timestamptz{Time: windowStart.UTC(), Valid: true},
+		).
+		ToSql()
+	if err != nil {
+		return LoginFailure{}, fmt.Errorf("building increment query for %s: %w", loginFailuresTable, err)
+	}
+
+	var out LoginFailure
+	if err = out.scan(q.db.QueryRow(ctx, query, args...)); err != nil {
+		return LoginFailure{}, err
+	}
+	return out, nil
+}
+
+// Lock holds the key until the surrounding transaction ends, whether it has a
+// counter or not, so concurrent attempts for the key are checked one by one.
+func (q LoginFailuresQ) Lock(ctx context.Context, scope, key string) error {
+	_, err := q.db.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", scope+":"+key)
+	if err != nil {
+		return fmt.Errorf("locking %s key %s %s: %w", loginFailuresTable, scope, key, err)
+	}
+
+	return nil
+}
+
+func (q LoginFailuresQ) Get(ctx context.Context) (LoginFailure, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return LoginFailure{}, fmt.Errorf("building get query for %s: %w", loginFailuresTable, err)
+	}
+
+	var f LoginFailure
+	err = f.scan(q.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return LoginFailure{}, nil
+		}
+		return LoginFailure{}, err
+	}
+
+	return f, nil
+}
+
+func (q LoginFailuresQ) Update(ctx context.Context) error {
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return fmt.Errorf("building update query for %s: %w", loginFailuresTable, err)
+	}
+
+	_, err = q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("executing update query for %s: %w", loginFailuresTable, err)
+	}
+
+	return nil
+}
+
+func (q LoginFailuresQ) UpdateLockedUntil(until time.Time) LoginFailuresQ {
+	q.updater = q.updater.Set("locked_until", pgtype.Timestamptz{Time: until.UTC(), Valid: true})
+	return q
+}
+
+func (q LoginFailuresQ) Delete(ctx context.Context) (int64, error) {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building delete query for %s: %w", loginFailuresTable, err)
+	}
+
+	tag, err := q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("executing delete query for %s: %w", loginFailuresTable, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (q LoginFailuresQ) FilterKey(scope, key string) LoginFailuresQ {
+	cond := sq.Eq{
+		"scope": pgtype.Text{String: scope, Valid: true},
+		"key":   pgtype.Text{String: key, Valid: true},
+	}
+
+	q.selector = q.selector.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	return q
+}
+
+// FilterStale keeps counters with no failures since before and no lock
+// lasting past now.
+func (q LoginFailuresQ) FilterStale(before, now time.Time) LoginFailuresQ {
+	cond := sq.And{
+		sq.Lt{"last_failure_at": pgtype.Timestamptz{Time: before.UTC(), Valid: true}},
+		sq.Or{
+			sq.Eq{"locked_until": nil},
+			sq.Lt{"locked_until": pgtype.Timestamptz{Time: now.UTC(), Valid: true}},
+		},
+	}
+
+	q.selector = q.selector.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	return q
+}
@@ -0,0 +1,164 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/netbill/pgxtx"
+)
+
+const memberTombstonesTable = "organization_member_tombstones"
+
+const organizationTombstonesTable = "organization_tombstones"
+
+// MemberTombstonesQ keeps IDs of deleted members and organizations, so late
+// or redelivered events can't bring them back into the replica.
+type MemberTombstonesQ struct {
+	db pgxtx.DBTX
+
+	memberSelector sq.SelectBuilder
+	memberInserter sq.InsertBuilder
+	memberDeleter  sq.DeleteBuilder
+	orgSelector    sq.SelectBuilder
+	orgInserter    sq.InsertBuilder
+	orgDeleter     sq.DeleteBuilder
+}
+
+func NewMemberTombstonesQ(db pgxtx.DBTX) MemberTombstonesQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return MemberTombstonesQ{
+		db:             db,
+		memberSelector: builder.Select("1").From(memberTombstonesTable),
+		memberInserter: builder.Insert(memberTombstonesTable).Columns("member_id", "deleted_at"),
+		memberDeleter:  builder.Delete(memberTombstonesTable),
+		orgSelector:    builder.Select("1").From(organizationTombstonesTable),
+		orgInserter:    builder.Insert(organizationTombstonesTable).Columns("organization_id", "deleted_at"),
+		orgDeleter:     builder.Delete(organizationTombstonesTable),
+	}
+}
+
+func (q MemberTombstonesQ) InsertMember(ctx context.Context, memberID uuid.UUID, deletedAt time.Time) error {
+	query, args, err := q.memberInserter.
+		Values(
+			pgtype.UUID{Bytes: [16]byte(memberID), Valid: true},
+			pgtype.Timestamptz{Time: deletedAt.UTC(), Valid: true},
+		).
+		Suffix("ON CONFLICT (member_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", memberTombstonesTable, err)
+	}
+
+	if _, err = q.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("executing insert query for %s: %w", memberTombstonesTable, err)
+	}
+	return nil
+}
+
+// InsertOrganization buries the organization together with all of its members
+// that are replicated at the moment.
+func (q MemberTombstonesQ) InsertOrganization(ctx context.Context, organizationID uuid.UUID, deletedAt time.Time) error {
+	orgID := pgtype.UUID{Bytes: [16]byte(organizationID), Valid: true}
+	at := pgtype.Timestamptz{Time: deletedAt.UTC(), Valid: true}
+
+	query, args, err := q.orgInserter.
+		Values(orgID, at).
+		Suffix("ON CONFLICT (organization_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", organizationTombstonesTable, err)
+	}
+
+	if _, err = q.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("executing insert query for %s: %w", organizationTombstonesTable, err)
+	}
+
+	members := sq.Select("id").
+		Column(sq.Expr("?::timestamptz", at)).
+		From(OrganizationMemberTable).
+		Where(sq.Eq{"organization_id": orgID})
+
+	query, args, err = q.memberInserter.
+		Select(members).
+		Suffix("ON CONFLICT (member_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", memberTombstonesTable, err)
+	}
+
+	if _, err = q.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("executing insert query for %s: %w", memberTombstonesTable, err)
+	}
+	return nil
+}
+
+func (q MemberTombstonesQ) ExistsMember(ctx context.Context, memberID uuid.UUID) (bool, error) {
+	return q.exists(ctx, q.memberSelector.Where(sq.Eq{
+		"member_id": pgtype.UUID{Bytes: [16]byte(memberID), Valid: true},
+	}))
+}
+
+func (q MemberTombstonesQ) ExistsOrganization(ctx context.Context, organizationID uuid.UUID) (bool, error) {
+	return q.exists(ctx, q.orgSelector.Where(sq.Eq{
+		"organization_id": pgtype.UUID{Bytes: [16]byte(organizationID), Valid: true},
+	}))
+}
+
+// DeleteMembersBefore drops up to limit member tombstones left before t.
+func (q MemberTombstonesQ) DeleteMembersBefore(ctx context.Context, t time.Time, limit uint) (uint, error) {
+	return q.deleteBefore(ctx, q.memberDeleter, memberTombstonesTable, "member_id", t, limit)
+}
+
+// DeleteOrganizationsBefore drops up to limit organization tombstones left before t.
+func (q MemberTombstonesQ) DeleteOrganizationsBefore(ctx context.Context, t time.Time, limit uint) (uint, error) {
+	return q.deleteBefore(ctx, q.orgDeleter, organizationTombstonesTable, "organization_id", t, limit)
+}
+
+func (q MemberTombstonesQ) deleteBefore(
+	ctx context.Context,
+	deleter sq.DeleteBuilder,
+	table, idColumn string,
+	t time.Time,
+	limit uint,
+) (uint, error) {
+	sub := sq.Select(idColumn).
+		From(table).
+		Where(sq.Lt{"deleted_at": pgtype.Timestamptz{Time: t.UTC(), Valid: true}}).
+		Limit(uint64(limit))
+
+	query, args, err := deleter.Where(sq.Expr(idColumn+" IN (?)", sub)).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building delete query for %s: %w", table, err)
+	}
+
+	tag, err := q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("executing delete query for %s: %w", table, err)
+	}
+
+	return uint(tag.RowsAffected()), nil
+}
+
+func (q MemberTombstonesQ) exists(ctx context.Context, selector sq.SelectBuilder) (bool, error) {
+	query, args, err := selector.Limit(1).ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var one int
+	err = q.db.QueryRow(ctx, query, args...).Scan(&one)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -15,16 +15,20 @@ import (
 
 const OrganizationMemberTable = "organization_members"
 
-const OrganizationMemberColumns = "id, account_id, organization_id, source_created_at, replica_created_at"
-const OrganizationMemberColumnsM = "m.id, m.account_id, m.organization_id, m.source_created_at, m.replica_created_at"
+const OrganizationMemberColumns = "id, account_id, organization_id, position, label, source_created_at, source_updated_at, replica_created_at, replica_updated_at"
+const OrganizationMemberColumnsM = "m.id, m.account_id, m.organization_id, m.position, m.label, m.source_created_at, m.source_updated_at, m.replica_created_at, m.replica_updated_at"
 
 type OrganizationMember struct {
 	ID             pgtype.UUID `json:"id"`
 	AccountID      pgtype.UUID `json:"account_id"`
 	OrganizationID pgtype.UUID `json:"organization_id"`
+	Position       pgtype.Text `json:"position"`
+	Label          pgtype.Text `json:"label"`
 
 	SourceCreatedAt  pgtype.Timestamptz `json:"source_created_at"`
+	SourceUpdatedAt  pgtype.Timestamptz `json:"source_updated_at"`
 	ReplicaCreatedAt pgtype.Timestamptz `json:"replica_created_at"`
+	ReplicaUpdatedAt pgtype.Timestamptz `json:"replica_updated_at"`
 }
 
 func (m *OrganizationMember) scan(row sq.RowScanner) error {
@@ -32,8 +36,12 @@ func (m *OrganizationMember) scan(row sq.RowScanner) error {
 		&m.ID,
 		&m.AccountID,
 		&m.OrganizationID,
+		&m.Position,
+		&m.Label,
 		&m.SourceCreatedAt,
+		&m.SourceUpdatedAt,
 		&m.ReplicaCreatedAt,
+		&m.ReplicaUpdatedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("scanning organization member: %w", err)
@@ -45,6 +53,7 @@ type OrganizationMembersQ struct {
 	db       pgxtx.DBTX
 	selector sq.SelectBuilder
 	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
 	deleter  sq.DeleteBuilder
 	counter  sq.SelectBuilder
 }
@@ -55,6 +64,7 @@ func NewOrganizationMembersQ(db pgxtx.DBTX) OrganizationMembersQ {
 		db:       db,
 		selector: builder.Select(OrganizationMemberColumnsM).From(OrganizationMemberTable + " m"),
 		inserter: builder.Insert(OrganizationMemberTable),
+		updater:  builder.Update(OrganizationMemberTable + " m"),
 		deleter:  builder.Delete(OrganizationMemberTable + " m"),
 		counter:  builder.Select("COUNT(*)").From(OrganizationMemberTable + " m"),
 	}
@@ -64,8 +74,11 @@ type OrganizationMemberInsertInput struct {
 	ID             uuid.UUID
 	AccountID      uuid.UUID
 	OrganizationID uuid.UUID
+	Position       *string
+	Label          *string
 
 	SourceCreatedAt time.Time
+	SourceUpdatedAt time.Time
 }
 
 func (q OrganizationMembersQ) Insert(ctx context.Context, data OrganizationMemberInsertInput) (OrganizationMember, error) {
@@ -73,10 +86,16 @@ func (q OrganizationMembersQ) Insert(ctx context.Context, data OrganizationMembe
 		"id":              pgtype.UUID{Bytes: [16]byte(data.ID), Valid: true},
 		"account_id":      pgtype.UUID{Bytes: [16]byte(data.AccountID), Valid: true},
 		"organization_id": pgtype.UUID{Bytes: [16]byte(data.OrganizationID), Valid: true},
+		"position":        nullableText(data.Position),
+		"label":           nullableText(data.Label),
 		"source_created_at": pgtype.Timestamptz{
 			Time:  data.SourceCreatedAt.UTC(),
 			Valid: true,
 		},
+		"source_updated_at": pgtype.Timestamptz{
+			Time:  data.SourceUpdatedAt.UTC(),
+			Valid: true,
+		},
 		"replica_created_at": pgtype.Timestamptz{
 			Time:  time.Now().UTC(),
 			Valid: true,
@@ -93,6 +112,44 @@ func (q OrganizationMembersQ) Insert(ctx context.Context, data OrganizationMembe
 	return inserted, nil
 }
 
+// OnConflictDoNothing makes Insert skip rows that already exist,
+// in that case Insert returns pgx.ErrNoRows.
+func (q OrganizationMembersQ) OnConflictDoNothing() OrganizationMembersQ {
+	q.inserter = q.inserter.Suffix("ON CONFLICT DO NOTHING")
+	return q
+}
+
+func (q OrganizationMembersQ) UpdateMany(ctx context.Context) (int64, error) {
+	q.updater = q.updater.Set("replica_updated_at", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true})
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building update query for %s: %w", OrganizationMemberTable, err)
+	}
+
+	tag, err := q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("executing update query for %s: %w", OrganizationMemberTable, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (q OrganizationMembersQ) UpdatePosition(position *string) OrganizationMembersQ {
+	q.updater = q.updater.Set("position", nullableText(position))
+	return q
+}
+
+func (q OrganizationMembersQ) UpdateLabel(label *string) OrganizationMembersQ {
+	q.updater = q.updater.Set("label", nullableText(label))
+	return q
+}
+
+func (q OrganizationMembersQ) UpdateSourceUpdatedAt(updatedAt time.Time) OrganizationMembersQ {
+	q.updater = q.updater.Set("source_updated_at", pgtype.Timestamptz{Time: updatedAt.UTC(), Valid: true})
+	return q
+}
+
 func (q OrganizationMembersQ) Get(ctx context.Context) (OrganizationMember, error) {
 	query, args, err := q.selector.Limit(1).ToSql()
 	if err != nil {
@@ -138,6 +195,7 @@ func (q OrganizationMembersQ) Select(ctx context.Context) ([]OrganizationMember,
 
 func (q OrganizationMembersQ) Exists(ctx context.Context) (bool, error) {
 	query, args, err := q.selector.
+		RemoveColumns().
 		Columns("1").
 		Limit(1).
 		ToSql()
@@ -176,6 +234,7 @@ func (q OrganizationMembersQ) FilterByID(id uuid.UUID) OrganizationMembersQ {
 
 	q.selector = q.selector.Where(sq.Eq{"m.id": pid})
 	q.counter = q.counter.Where(sq.Eq{"m.id": pid})
+	q.updater = q.updater.Where(sq.Eq{"m.id": pid})
 	q.deleter = q.deleter.Where(sq.Eq{"m.id": pid})
 	return q
 }
@@ -185,6 +244,7 @@ func (q OrganizationMembersQ) FilterByAccountID(accountID uuid.UUID) Organizatio
 
 	q.selector = q.selector.Where(sq.Eq{"m.account_id": pid})
 	q.counter = q.counter.Where(sq.Eq{"m.account_id": pid})
+	q.updater = q.updater.Where(sq.Eq{"m.account_id": pid})
 	q.deleter = q.deleter.Where(sq.Eq{"m.account_id": pid})
 	return q
 }
@@ -194,10 +254,23 @@ func (q OrganizationMembersQ) FilterByOrganizationID(organizationID uuid.UUID) O
 
 	q.selector = q.selector.Where(sq.Eq{"m.organization_id": pid})
 	q.counter = q.counter.Where(sq.Eq{"m.organization_id": pid})
+	q.updater = q.updater.Where(sq.Eq{"m.organization_id": pid})
 	q.deleter = q.deleter.Where(sq.Eq{"m.organization_id": pid})
 	return q
 }
 
+// FilterSourceUpdatedBefore keeps rows whose source version is older than the given one,
+// so replayed or reordered events never overwrite newer data.
+func (q OrganizationMembersQ) FilterSourceUpdatedBefore(updatedAt time.Time) OrganizationMembersQ {
+	val := pgtype.Timestamptz{Time: updatedAt.UTC(), Valid: true}
+
+	q.selector = q.selector.Where(sq.Lt{"m.source_updated_at": val})
+	q.counter = q.counter.Where(sq.Lt{"m.source_updated_at": val})
+	q.updater = q.updater.Where(sq.Lt{"m.source_updated_at": val})
+	q.deleter = q.deleter.Where(sq.Lt{"m.source_updated_at": val})
+	return q
+}
+
 func (q OrganizationMembersQ) Page(limit, offset uint) OrganizationMembersQ {
 	q.selector = q.selector.Limit(uint64(limit)).Offset(uint64(offset))
 	return q
@@ -219,3 +292,27 @@ func (q OrganizationMembersQ) Count(ctx context.Context) (uint, error) {
 
 	return uint(count), nil
 }
+
+func nullableText(val *string) pgtype.Text {
+	if val == nil {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{String: *val, Valid: true}
+}
+
+func nullableTimestamptz(val *time.Time) pgtype.Timestamptz {
+	if val == nil {
+		return pgtype.Timestamptz{}
+	}
+
+	return pgtype.Timestamptz{Time: val.UTC(), Valid: true}
+}
+
+func optionalText(val string) pgtype.Text {
+	if val == "" {
+		return pgtype.Text{}
+	}
+
+	return pgtype.Text{String: val, Valid: true}
+}
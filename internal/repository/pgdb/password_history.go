@@ -0,0 +1,145 @@
+package pgdb
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/netbill/pgxtx"
+)
+
+const passwordHistoryTable = "account_password_history"
+
+const passwordHistoryColumns = "id, account_id, hash, created_at"
+
+type PasswordHistoryEntry struct {
+	ID        pgtype.UUID        `db:"id"`
+	AccountID pgtype.UUID        `db:"account_id"`
+	Hash      pgtype.Text        `db:"hash"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+func (e *PasswordHistoryEntry) scan(row sq.RowScanner) error {
+	err := row.Scan(
+		&e.ID,
+		&e.AccountID,
+		&e.Hash,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("scanning password history entry: %w", err)
+	}
+	return nil
+}
+
+type PasswordHistoryQ struct {
+	db       pgxtx.DBTX
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	deleter  sq.DeleteBuilder
+}
+
+func NewPasswordHistoryQ(db pgxtx.DBTX) PasswordHistoryQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return PasswordHistoryQ{
+		db:       db,
+		selector: builder.Select(passwordHistoryColumns).From(passwordHistoryTable),
+		inserter: builder.Insert(passwordHistoryTable),
+		deleter:  builder.Delete(passwordHistoryTable),
+	}
+}
+
+func (q PasswordHistoryQ) Insert(ctx context.Context, accountID uuid.UUID, hash string) error {
+	query, args, err := q.inserter.SetMap(map[string]interface{}{
+		"id":         pgtype.UUID{Bytes: [16]byte(uuid.New()), Valid: true},
+		"account_id": pgtype.UUID{Bytes: [16]byte(accountID), Valid: true},
+		"hash":       pgtype.Text{String: hash, Valid: true},
+	}).ToSql()
+	if err != nil {
+		return fmt.Errorf("building insert query for %s: %w", passwordHistoryTable, err)
+	}
+
+	_, err = q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("executing insert query for %s: %w", passwordHistoryTable, err)
+	}
+
+	return nil
+}
+
+func (q PasswordHistoryQ) Select(ctx context.Context) ([]PasswordHistoryEntry, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", passwordHistoryTable, err)
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]PasswordHistoryEntry, 0)
+	for rows.Next() {
+		var e PasswordHistoryEntry
+		if err = e.scan(rows); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// DeleteExceptNewest keeps only the keep most recent entries of the account.
+func (q PasswordHistoryQ) DeleteExceptNewest(ctx context.Context, accountID uuid.UUID, keep uint) error {
+	id := pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}
+
+	newest := sq.Select("id").
+		From(passwordHistoryTable).
+		Where(sq.Eq{"account_id": id}).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(keep))
+
+	query, args, err := q.deleter.
+		Where(sq.Eq{"account_id": id}).
+		Where(sq.Expr("id NOT IN (?)", newest)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query for %s: %w", passwordHistoryTable, err)
+	}
+
+	_, err = q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("executing delete query for %s: %w", passwordHistoryTable, err)
+	}
+
+	return nil
+}
+
+func (q PasswordHistoryQ) FilterAccountID(accountID uuid.UUID) PasswordHistoryQ {
+	id := pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}
+
+	q.selector = q.selector.Where(sq.Eq{"account_id": id})
+	return q
+}
+
+func (q PasswordHistoryQ) OrderCreatedAt(ascending bool) PasswordHistoryQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC", "id ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC", "id DESC")
+	}
+	return q
+}
+
+func (q PasswordHistoryQ) Limit(limit uint) PasswordHistoryQ {
+	q.selector = q.selector.Limit(uint64(limit))
+	return q
+}
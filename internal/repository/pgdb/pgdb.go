@@ -1,6 +1,8 @@
 package pgdb
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/models"
 )
@@ -11,12 +13,26 @@ func (a *Account) ToModel() models.Account {
 		id = a.ID.Bytes
 	}
 
+	var statusReason *string
+	if a.StatusReason.Valid {
+		statusReason = &a.StatusReason.String
+	}
+
+	var statusUntil *time.Time
+	if a.StatusUntil.Valid {
+		statusUntil = &a.StatusUntil.Time
+	}
+
 	return models.Account{
-		ID:        id,
-		Username:  a.Username.String,
-		Role:      a.Role.String,
-		CreatedAt: a.CreatedAt.Time,
-		UpdatedAt: a.UpdatedAt.Time,
+		ID:           id,
+		Username:     a.Username.String,
+		Role:         a.Role.String,
+		Status:       a.Status.String,
+		StatusReason: statusReason,
+		StatusUntil:  statusUntil,
+		Version:      a.Version.Int64,
+		CreatedAt:    a.CreatedAt.Time,
+		UpdatedAt:    a.UpdatedAt.Time,
 	}
 }
 
@@ -27,10 +43,12 @@ func (a *AccountPassword) ToModel() models.AccountPassword {
 	}
 
 	return models.AccountPassword{
-		AccountID: accountID,
-		Hash:      a.Hash.String,
-		CreatedAt: a.CreatedAt.Time,
-		UpdatedAt: a.UpdatedAt.Time,
+		AccountID:  accountID,
+		Hash:       a.Hash.String,
+		MustChange: a.MustChange.Bool,
+		LastChange: a.LastChange.String,
+		CreatedAt:  a.CreatedAt.Time,
+		UpdatedAt:  a.UpdatedAt.Time,
 	}
 }
 
@@ -60,10 +78,160 @@ func (s *Session) ToModel() models.Session {
 		accountID = s.AccountID.Bytes
 	}
 
+	var activeOrganizationID *uuid.UUID
+	if s.ActiveOrganizationID.Valid {
+		orgID := uuid.UUID(s.ActiveOrganizationID.Bytes)
+		activeOrganizationID = &orgID
+	}
+
+	var deviceName *string
+	if s.DeviceName.Valid {
+		deviceName = &s.DeviceName.String
+	}
+
 	return models.Session{
+		ID:                   id,
+		AccountID:            accountID,
+		ActiveOrganizationID: activeOrganizationID,
+		IP:                   s.IP.String,
+		UserAgent:            s.UserAgent.String,
+		OS:                   s.OS.String,
+		Browser:              s.Browser.String,
+		DeviceName:           deviceName,
+		LastUsed:             s.LastUsed.Time,
+		CreatedAt:            s.CreatedAt.Time,
+	}
+}
+
+func (m *OrganizationMember) ToModel() models.Member {
+	var id uuid.UUID
+	if m.ID.Valid {
+		id = m.ID.Bytes
+	}
+
+	var accountID uuid.UUID
+	if m.AccountID.Valid {
+		accountID = m.AccountID.Bytes
+	}
+
+	var organizationID uuid.UUID
+	if m.OrganizationID.Valid {
+		organizationID = m.OrganizationID.Bytes
+	}
+
+	var position *string
+	if m.Position.Valid {
+		position = &m.Position.String
+	}
+
+	var label *string
+	if m.Label.Valid {
+		label = &m.Label.String
+	}
+
+	return models.Member{
+		ID:             id,
+		AccountID:      accountID,
+		OrganizationID: organizationID,
+		Position:       position,
+		Label:          label,
+		CreatedAt:      m.SourceCreatedAt.Time,
+		UpdatedAt:      m.SourceUpdatedAt.Time,
+	}
+}
+
+func (f *LoginFailure) ToModel() models.LoginFailures {
+	var lockedUntil *time.Time
+	if f.LockedUntil.Valid {
+		lockedUntil = &f.LockedUntil.Time
+	}
+
+	return models.LoginFailures{
+		Scope:         f.Scope.String,
+		Key:           f.Key.String,
+		Failures:      uint(f.Failures.Int32),
+		LastFailureAt: f.LastFailureAt.Time,
+		LockedUntil:   lockedUntil,
+	}
+}
+
+func (e *SecurityEvent) ToModel() models.SecurityEvent {
+	var id uuid.UUID
+	if e.ID.Valid {
+		id = e.ID.Bytes
+	}
+
+	var accountID uuid.UUID
+	if e.AccountID.Valid {
+		accountID = e.AccountID.Bytes
+	}
+
+	return models.SecurityEvent{
 		ID:        id,
 		AccountID: accountID,
-		LastUsed:  s.LastUsed.Time,
-		CreatedAt: s.CreatedAt.Time,
+		Type:      e.Type.String,
+		Method:    e.Method.String,
+		Reason:    e.Reason.String,
+		IP:        e.IP.String,
+		UserAgent: e.UserAgent.String,
+		CreatedAt: e.CreatedAt.Time,
+	}
+}
+
+func (d *KnownDevice) ToModel() models.KnownDevice {
+	var accountID uuid.UUID
+	if d.AccountID.Valid {
+		accountID = d.AccountID.Bytes
+	}
+
+	return models.KnownDevice{
+		AccountID:   accountID,
+		Fingerprint: d.Fingerprint.String,
+		IP:          d.IP.String,
+		OS:          d.OS.String,
+		Browser:     d.Browser.String,
+		FirstSeenAt: d.FirstSeenAt.Time,
+		LastSeenAt:  d.LastSeenAt.Time,
+	}
+}
+
+func (e *UsernameHistoryEntry) ToModel() models.UsernameChange {
+	var id uuid.UUID
+	if e.ID.Valid {
+		id = e.ID.Bytes
+	}
+
+	var accountID uuid.UUID
+	if e.AccountID.Valid {
+		accountID = e.AccountID.Bytes
+	}
+
+	return models.UsernameChange{
+		ID:        id,
+		AccountID: accountID,
+		Username:  e.Username.String,
+		ChangedAt: e.ChangedAt.Time,
+	}
+}
+
+func (d *AccountDeletion) ToModel() models.AccountDeletion {
+	var accountID uuid.UUID
+	if d.AccountID.Valid {
+		accountID = d.AccountID.Bytes
+	}
+
+	var timeoutAt *time.Time
+	if d.TimeoutAt.Valid {
+		timeoutAt = &d.TimeoutAt.Time
+	}
+
+	return models.AccountDeletion{
+		AccountID:   accountID,
+		State:       d.State.String,
+		Attempts:    uint(d.Attempts.Int32),
+		RequestedAt: d.RequestedAt.Time,
+		PurgeAfter:  d.PurgeAfter.Time,
+		TimeoutAt:   timeoutAt,
+		UpdatedAt:   d.UpdatedAt.Time,
 	}
 }
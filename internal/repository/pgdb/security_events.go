@@ -0,0 +1,200 @@
+package pgdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/netbill/pgxtx"
+)
+
+const securityEventsTable = "account_security_events"
+
+const securityEventsColumns = "id, account_id, type, method, reason, ip, user_agent, created_at"
+
+type SecurityEvent struct {
+	ID        pgtype.UUID        `db:"id"`
+	AccountID pgtype.UUID        `db:"account_id"`
+	Type      pgtype.Text        `db:"type"`
+	Method    pgtype.Text        `db:"method"`
+	Reason    pgtype.Text        `db:"reason"`
+	IP        pgtype.Text        `db:"ip"`
+	UserAgent pgtype.Text        `db:"user_agent"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+func (e *SecurityEvent) scan(row sq.RowScanner) error {
+	err := row.Scan(
+		&e.ID,
+		&e.AccountID,
+		&e.Type,
+		&e.Method,
+		&e.Reason,
+		&e.IP,
+		&e.UserAgent,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("scanning security event: %w", err)
+	}
+	return nil
+}
+
+type SecurityEventsQ struct {
+	db       pgxtx.DBTX
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewSecurityEventsQ(db pgxtx.DBTX) SecurityEventsQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return SecurityEventsQ{
+		db:       db,
+		selector: builder.Select(securityEventsColumns).From(securityEventsTable),
+		inserter: builder.Insert(securityEventsTable),
+		deleter:  builder.Delete(securityEventsTable),
+		counter:  builder.Select("COUNT(*) AS count").From(securityEventsTable),
+	}
+}
+
+type InsertSecurityEventParams struct {
+	AccountID uuid.UUID
+	Type      string
+	Method    string
+	Reason    string
+	IP        string
+	UserAgent string
+}
+
+func (q SecurityEventsQ) Insert(ctx context.Context, input InsertSecurityEventParams) (SecurityEvent, error) {
+	query, args, err := q.inserter.SetMap(map[string]interface{}{
+		"id":         pgtype.UUID{Bytes: [16]byte(uuid.New()), Valid: true},
+		"account_id": pgtype.UUID{Bytes: [16]byte(input.AccountID), Valid: true},
+		"type":       pgtype.Text{String: input.Type, Valid: true},
+		"method":     optionalText(input.Method),
+		"reason":     optionalText(input.Reason),
+		"ip":         optionalText(input.IP),
+		"user_agent": optionalText(input.UserAgent),
+	}).Suffix("RETURNING " + securityEventsColumns).ToSql()
+	if err != nil {
+		return SecurityEvent{}, fmt.Errorf("building insert query for %s: %w", securityEventsTable, err)
+	}
+
+	var out SecurityEvent
+	if err = out.scan(q.db.QueryRow(ctx, query, args...)); err != nil {
+		return SecurityEvent{}, err
+	}
+	return out, nil
+}
+
+func (q SecurityEventsQ) Select(ctx context.Context) ([]SecurityEvent, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building select query for %s: %w", securityEventsTable, err)
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SecurityEvent, 0)
+	for rows.Next() {
+		var e SecurityEvent
+		if err = e.scan(rows); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (q SecurityEventsQ) Count(ctx context.Context) (uint, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building count query for %s: %w", securityEventsTable, err)
+	}
+
+	var count int64
+	err = q.db.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("invalid count for %s: %d", securityEventsTable, count)
+	}
+
+	return uint(count), nil
+}
+
+// DeleteCreatedBefore drops up to limit events created before t.
+func (q SecurityEventsQ) DeleteCreatedBefore(ctx context.Context, t time.Time, limit uint) (uint, error) {
+	sub := sq.Select("id").
+		From(securityEventsTable).
+		Where(sq.Lt{"created_at": pgtype.Timestamptz{Time: t.UTC(), Valid: true}}).
+		Limit(uint64(limit))
+
+	query, args, err := q.deleter.Where(sq.Expr("id IN (?)", sub)).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building delete query for %s: %w", securityEventsTable, err)
+	}
+
+	tag, err := q.db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("executing delete query for %s: %w", securityEventsTable, err)
+	}
+
+	return uint(tag.RowsAffected()), nil
+}
+
+func (q SecurityEventsQ) FilterAccountID(accountID uuid.UUID) SecurityEventsQ {
+	id := pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}
+
+	q.selector = q.selector.Where(sq.Eq{"account_id": id})
+	q.counter = q.counter.Where(sq.Eq{"account_id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"account_id": id})
+	return q
+}
+
+func (q SecurityEventsQ) FilterType(eventType string) SecurityEventsQ {
+	t := pgtype.Text{String: eventType, Valid: true}
+
+	q.selector = q.selector.Where(sq.Eq{"type": t})
+	q.counter = q.counter.Where(sq.Eq{"type": t})
+	q.deleter = q.deleter.Where(sq.Eq{"type": t})
+	return q
+}
+
+func (q SecurityEventsQ) FilterCreatedAfter(t time.Time) SecurityEventsQ {
+	after := pgtype.Timestamptz{Time: t.UTC(), Valid: true}
+
+	q.selector = q.selector.Where(sq.Gt{"created_at": after})
+	q.counter = q.counter.Where(sq.Gt{"created_at": after})
+	q.deleter = q.deleter.Where(sq.Gt{"created_at": after})
+	return q
+}
+
+func (q SecurityEventsQ) OrderCreatedAt(ascending bool) SecurityEventsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("created_at ASC", "id ASC")
+	} else {
+		q.selector = q.selector.OrderBy("created_at DESC", "id DESC")
+	}
+	return q
+}
+
+func (q SecurityEventsQ) Page(limit, offset uint) SecurityEventsQ {
+	q.selector = q.selector.Limit(uint64(limit)).Offset(uint64(offset))
+	return q
+}
@@ -15,12 +15,20 @@ import (
 
 const sessionsTable = "sessions"
 
+const sessionsColumns = "id, account_id, hash_token, active_organization_id, ip, user_agent, os, browser, device_name, last_used, created_at"
+
 type Session struct {
-	ID        pgtype.UUID        `db:"id"`
-	AccountID pgtype.UUID        `db:"account_id"`
-	HashToken pgtype.Text        `db:"hash_token"`
-	LastUsed  pgtype.Timestamptz `db:"last_used"`
-	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	ID                   pgtype.UUID        `db:"id"`
+	AccountID            pgtype.UUID        `db:"account_id"`
+	HashToken            pgtype.Text        `db:"hash_token"`
+	ActiveOrganizationID pgtype.UUID        `db:"active_organization_id"`
+	IP                   pgtype.Text        `db:"ip"`
+	UserAgent            pgtype.Text        `db:"user_agent"`
+	OS                   pgtype.Text        `db:"os"`
+	Browser              pgtype.Text        `db:"browser"`
+	DeviceName           pgtype.Text        `db:"device_name"`
+	LastUsed             pgtype.Timestamptz `db:"last_used"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at"`
 }
 
 func (s *Session) scan(row sq.RowScanner) error {
@@ -28,6 +36,12 @@ func (s *Session) scan(row sq.RowScanner) error {
 		&s.ID,
 		&s.AccountID,
 		&s.HashToken,
+		&s.ActiveOrganizationID,
+		&s.IP,
+		&s.UserAgent,
+		&s.OS,
+		&s.Browser,
+		&s.DeviceName,
 		&s.LastUsed,
 		&s.CreatedAt,
 	)
@@ -50,7 +64,7 @@ func NewSessionsQ(db pgxtx.DBTX) SessionsQ {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	return SessionsQ{
 		db:       db,
-		selector: builder.Select(sessionsTable + ".*").From(sessionsTable),
+		selector: builder.Select(sessionsColumns).From(sessionsTable),
 		inserter: builder.Insert(sessionsTable),
 		updater:  builder.Update(sessionsTable),
 		deleter:  builder.Delete(sessionsTable),
@@ -59,17 +73,27 @@ func NewSessionsQ(db pgxtx.DBTX) SessionsQ {
 }
 
 type InsertSessionParams struct {
-	ID        uuid.UUID
-	AccountID uuid.UUID
-	HashToken string
+	ID         uuid.UUID
+	AccountID  uuid.UUID
+	HashToken  string
+	IP         string
+	UserAgent  string
+	OS         string
+	Browser    string
+	DeviceName *string
 }
 
 func (q SessionsQ) Insert(ctx context.Context, input InsertSessionParams) (Session, error) {
 	query, args, err := q.inserter.SetMap(map[string]interface{}{
-		"id":         pgtype.UUID{Bytes: [16]byte(input.ID), Valid: true},
-		"account_id": pgtype.UUID{Bytes: [16]byte(input.AccountID), Valid: true},
-		"hash_token": pgtype.Text{String: input.HashToken, Valid: true},
-	}).Suffix("RETURNING id, account_id, hash_token, last_used, created_at").ToSql()
+		"id":          pgtype.UUID{Bytes: [16]byte(input.ID), Valid: true},
+		"account_id":  pgtype.UUID{Bytes: [16]byte(input.AccountID), Valid: true},
+		"hash_token":  pgtype.Text{String: input.HashToken, Valid: true},
+		"ip":          optionalText(input.IP),
+		"user_agent":  optionalText(input.UserAgent),
+		"os":          optionalText(input.OS),
+		"browser":     optionalText(input.Browser),
+		"device_name": nullableText(input.DeviceName),
+	}).Suffix("RETURNING " + sessionsColumns).ToSql()
 	if err != nil {
 		return Session{}, fmt.Errorf("building insert query for %s: %w", sessionsTable, err)
 	}
@@ -84,9 +108,7 @@ func (q SessionsQ) Insert(ctx context.Context, input InsertSessionParams) (Sessi
 }
 
 func (q SessionsQ) Update(ctx context.Context) ([]Session, error) {
-	q.updater = q.updater.
-		Set("last_used", pgtype.Timestamptz{Time: time.Now().UTC(), Valid: true}).
-		Suffix("RETURNING " + sessionsTable + ".*")
+	q.updater = q.updater.Suffix("RETURNING " + sessionsColumns)
 
 	query, args, err := q.updater.ToSql()
 	if err != nil {
@@ -121,11 +143,59 @@ func (q SessionsQ) UpdateToken(token string) SessionsQ {
 	return q
 }
 
+func (q SessionsQ) UpdateActiveOrganization(organizationID *uuid.UUID) SessionsQ {
+	val := pgtype.UUID{}
+	if organizationID != nil {
+		val = pgtype.UUID{Bytes: [16]byte(*organizationID), Valid: true}
+	}
+
+	q.updater = q.updater.Set("active_organization_id", val)
+	return q
+}
+
+func (q SessionsQ) UpdateIP(ip string) SessionsQ {
+	q.updater = q.updater.Set("ip", optionalText(ip))
+	return q
+}
+
+func (q SessionsQ) UpdateDeviceName(name *string) SessionsQ {
+	q.updater = q.updater.Set("device_name", nullableText(name))
+	return q
+}
+
 func (q SessionsQ) UpdateLastUsed(lastUsed time.Time) SessionsQ {
 	q.updater = q.updater.Set("last_used", pgtype.Timestamptz{Time: lastUsed.UTC(), Valid: true})
 	return q
 }
 
+// TouchMany moves last_used forward for many sessions in one statement,
+// values older than the stored ones are ignored.
+func (q SessionsQ) TouchMany(ctx context.Context, lastUsed map[uuid.UUID]time.Time) (int64, error) {
+	if len(lastUsed) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]pgtype.UUID, 0, len(lastUsed))
+	times := make([]pgtype.Timestamptz, 0, len(lastUsed))
+	for id, t := range lastUsed {
+		ids = append(ids, pgtype.UUID{Bytes: [16]byte(id), Valid: true})
+		times = append(times, pgtype.Timestamptz{Time: t.UTC(), Valid: true})
+	}
+
+	query := `
+		UPDATE ` + sessionsTable + ` AS s
+		SET last_used = v.last_used
+		FROM unnest($1::uuid[], $2::timestamptz[]) AS v(id, last_used)
+		WHERE s.id = v.id AND s.last_used < v.last_used`
+
+	tag, err := q.db.Exec(ctx, query, ids, times)
+	if err != nil {
+		return 0, fmt.Errorf("executing touch query for %s: %w", sessionsTable, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
 func (q SessionsQ) Get(ctx context.Context) (Session, error) {
 	query, args, err := q.selector.Limit(1).ToSql()
 	if err != nil {
@@ -175,6 +245,7 @@ func (q SessionsQ) Select(ctx context.Context) ([]Session, error) {
 
 func (q SessionsQ) Exists(ctx context.Context) (bool, error) {
 	query, args, err := q.selector.
+		RemoveColumns().
 		Columns("1").
 		Limit(1).
 		ToSql()
@@ -215,6 +286,15 @@ func (q SessionsQ) FilterID(ID uuid.UUID) SessionsQ {
 	return q
 }
 
+func (q SessionsQ) FilterHashToken(token string) SessionsQ {
+	q.selector = q.selector.Where(sq.Eq{"hash_token": token})
+	q.deleter = q.deleter.Where(sq.Eq{"hash_token": token})
+	q.updater = q.updater.Where(sq.Eq{"hash_token": token})
+	q.counter = q.counter.Where(sq.Eq{"hash_token": token})
+
+	return q
+}
+
 func (q SessionsQ) FilterAccountID(accountID uuid.UUID) SessionsQ {
 	pid := pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}
 
@@ -226,6 +306,64 @@ func (q SessionsQ) FilterAccountID(accountID uuid.UUID) SessionsQ {
 	return q
 }
 
+func (q SessionsQ) FilterIDs(ids ...uuid.UUID) SessionsQ {
+	pids := make([]pgtype.UUID, 0, len(ids))
+	for _, id := range ids {
+		pids = append(pids, pgtype.UUID{Bytes: [16]byte(id), Valid: true})
+	}
+
+	q.selector = q.selector.Where(sq.Eq{"id": pids})
+	q.deleter = q.deleter.Where(sq.Eq{"id": pids})
+	q.updater = q.updater.Where(sq.Eq{"id": pids})
+	q.counter = q.counter.Where(sq.Eq{"id": pids})
+
+	return q
+}
+
+// FilterAccountRoles keeps sessions of accounts with one of the given roles,
+// or, when exclude is set, of accounts with any other role.
+func (q SessionsQ) FilterAccountRoles(exclude bool, roles ...string) SessionsQ {
+	cond := "account_id IN (SELECT id FROM accounts WHERE role::text = ANY(?))"
+	if exclude {
+		cond = "account_id IN (SELECT id FROM accounts WHERE NOT (role::text = ANY(?)))"
+	}
+	expr := sq.Expr(cond, roles)
+
+	q.selector = q.selector.Where(expr)
+	q.deleter = q.deleter.Where(expr)
+	q.updater = q.updater.Where(expr)
+	q.counter = q.counter.Where(expr)
+
+	return q
+}
+
+// FilterExpired keeps sessions unused since idleBefore or created before
+// createdBefore; a nil bound is not checked.
+func (q SessionsQ) FilterExpired(idleBefore, createdBefore *time.Time) SessionsQ {
+	cond := sq.Or{}
+	if idleBefore != nil {
+		cond = append(cond, sq.Lt{"last_used": pgtype.Timestamptz{Time: idleBefore.UTC(), Valid: true}})
+	}
+	if createdBefore != nil {
+		cond = append(cond, sq.Lt{"created_at": pgtype.Timestamptz{Time: createdBefore.UTC(), Valid: true}})
+	}
+	if len(cond) == 0 {
+		return q
+	}
+
+	q.selector = q.selector.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.counter = q.counter.Where(cond)
+
+	return q
+}
+
+func (q SessionsQ) ForUpdateSkipLocked() SessionsQ {
+	q.selector = q.selector.Suffix("FOR UPDATE SKIP LOCKED")
+	return q
+}
+
 func (q SessionsQ) OrderCreatedAt(ascending bool) SessionsQ {
 	if ascending {
 		q.selector = q.selector.OrderBy("created_at ASC")
@@ -235,6 +373,15 @@ func (q SessionsQ) OrderCreatedAt(ascending bool) SessionsQ {
 	return q
 }
 
+func (q SessionsQ) OrderLastUsed(ascending bool) SessionsQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("last_used ASC")
+	} else {
+		q.selector = q.selector.OrderBy("last_used DESC")
+	}
+	return q
+}
+
 func (q SessionsQ) Count(ctx context.Context) (uint, error) {
 	query, args, err := q.counter.ToSql()
 	if err != nil {
@@ -0,0 +1,155 @@
+package pgdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/netbill/pgxtx"
+)
+
+const usernameHistoryTable = "account_username_history"
+
+const usernameHistoryColumns = "id, account_id, username, username_skeleton, changed_at"
+
+type UsernameHistoryEntry struct {
+	ID               pgtype.UUID        `db:"id"`
+	AccountID        pgtype.UUID        `db:"account_id"`
+	Username         pgtype.Text        `db:"username"`
+	UsernameSkeleton pgtype.Text        `db:"username_skeleton"`
+	ChangedAt        pgtype.Timestamptz `db:"changed_at"`
+}
+
+func (e *UsernameHistoryEntry) scan(row sq.RowScanner) error {
+	err := row.Scan(
+		&e.ID,
+		&e.AccountID,
+		&e.Username,
+		&e.UsernameSkeleton,
+		&e.ChangedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("scanning username history entry: %w", err)
+	}
+	return nil
+}
+
+type UsernameHistoryQ struct {
+	db       pgxtx.DBTX
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+}
+
+func NewUsernameHistoryQ(db pgxtx.DBTX) UsernameHistoryQ {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return UsernameHistoryQ{
+		db:       db,
+		selector: builder.Select(usernameHistoryColumns).From(usernameHistoryTable),
+		inserter: builder.Insert(usernameHistoryTable),
+	}
+}
+
+type InsertUsernameHistoryParams struct {
+	AccountID        uuid.UUID
+	Username         string
+	UsernameSkeleton string
+	ChangedAt        time.Time
+}
+
+func (q UsernameHistoryQ) Insert(ctx context.Context, input InsertUsernameHistoryParams) (UsernameHistoryEntry, error) {
+	query, args, err := q.inserter.SetMap(map[string]interface{}{
+		"id":                pgtype.UUID{Bytes: [16]byte(uuid.New()), Valid: true},
+		"account_id":        pgtype.UUID{Bytes: [16]byte(input.AccountID), Valid: true},
+		"username":          pgtype.Text{String: input.Username, Valid: true},
+		"username_skeleton": pgtype.Text{String: input.UsernameSkeleton, Valid: true},
+		"changed_at":        pgtype.Timestamptz{Time: input.ChangedAt.UTC(), Valid: true},
+	}).Suffix("RETURNING " + usernameHistoryColumns).ToSql()
+	if err != nil {
+		return UsernameHistoryEntry{}, fmt.Errorf("building insert query for %s: %w", usernameHistoryTable, err)
+	}
+
+	var out UsernameHistoryEntry
+	if err = out.scan(q.db.QueryRow(ctx, query, args...)); err != nil {
+		return UsernameHistoryEntry{}, err
+	}
+	return out, nil
+}
+
+func (q UsernameHistoryQ) Get(ctx context.Context) (UsernameHistoryEntry, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return UsernameHistoryEntry{}, fmt.Errorf("building get query for %s: %w", usernameHistoryTable, err)
+	}
+
+	var e UsernameHistoryEntry
+	err = e.scan(q.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return UsernameHistoryEntry{}, nil
+		}
+		return UsernameHistoryEntry{}, err
+	}
+
+	return e, nil
+}
+
+func (q UsernameHistoryQ) Exists(ctx context.Context) (bool, error) {
+	query, args, err := q.selector.
+		RemoveColumns().
+		Columns("1").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var one int
+	err = q.db.QueryRow(ctx, query, args...).Scan(&one)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (q UsernameHistoryQ) FilterAccountID(accountID uuid.UUID) UsernameHistoryQ {
+	q.selector = q.selector.Where(sq.Eq{"account_id": pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}})
+	return q
+}
+
+func (q UsernameHistoryQ) FilterNotAccountID(accountID uuid.UUID) UsernameHistoryQ {
+	q.selector = q.selector.Where(sq.NotEq{"account_id": pgtype.UUID{Bytes: [16]byte(accountID), Valid: true}})
+	return q
+}
+
+func (q UsernameHistoryQ) FilterUsername(username string) UsernameHistoryQ {
+	q.selector = q.selector.Where(sq.Eq{"username": pgtype.Text{String: username, Valid: true}})
+	return q
+}
+
+func (q UsernameHistoryQ) FilterUsernameSkeleton(skeleton string) UsernameHistoryQ {
+	q.selector = q.selector.Where(sq.Eq{"username_skeleton": pgtype.Text{String: skeleton, Valid: true}})
+	return q
+}
+
+func (q UsernameHistoryQ) FilterChangedAfter(t time.Time) UsernameHistoryQ {
+	q.selector = q.selector.Where(sq.Gt{"changed_at": pgtype.Timestamptz{Time: t.UTC(), Valid: true}})
+	return q
+}
+
+func (q UsernameHistoryQ) OrderChangedAt(ascending bool) UsernameHistoryQ {
+	if ascending {
+		q.selector = q.selector.OrderBy("changed_at ASC", "id ASC")
+	} else {
+		q.selector = q.selector.OrderBy("changed_at DESC", "id DESC")
+	}
+	return q
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/netbill/auth-svc/internal/repository/pgdb"
 	"github.com/netbill/pgxtx"
@@ -36,6 +38,53 @@ func (r Repository) orgMembersQ(ctx context.Context) pgdb.OrganizationMembersQ {
 	return pgdb.NewOrganizationMembersQ(pgxtx.Exec(r.pool, ctx))
 }
 
+func (r Repository) memberTombstonesQ(ctx context.Context) pgdb.MemberTombstonesQ {
+	return pgdb.NewMemberTombstonesQ(pgxtx.Exec(r.pool, ctx))
+}
+
+func (r Repository) loginFailuresQ(ctx context.Context) pgdb.LoginFailuresQ {
+	return pgdb.NewLoginFailuresQ(pgxtx.Exec(r.pool, ctx))
+}
+
+func (r Repository) securityEventsQ(ctx context.Context) pgdb.SecurityEventsQ {
+	return pgdb.NewSecurityEventsQ(pgxtx.Exec(r.pool, ctx))
+}
+
+func (r Repository) knownDevicesQ(ctx context.Context) pgdb.KnownDevicesQ {
+	return pgdb.NewKnownDevicesQ(pgxtx.Exec(r.pool, ctx))
+}
+
+func (r Repository) passwordHistoryQ(ctx context.Context) pgdb.PasswordHistoryQ {
+	return pgdb.NewPasswordHistoryQ(pgxtx.Exec(r.pool, ctx))
+}
+
+func (r Repository) usernameHistoryQ(ctx context.Context) pgdb.UsernameHistoryQ {
+	return pgdb.NewUsernameHistoryQ(pgxtx.Exec(r.pool, ctx))
+}
+
+func (r Repository) accountDeletionsQ(ctx context.Context) pgdb.AccountDeletionsQ {
+	return pgdb.NewAccountDeletionsQ(pgxtx.Exec(r.pool, ctx))
+}
+
 func (r Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
 	return pgxtx.Transaction(r.pool, ctx, fn)
 }
+
+const pgUniqueViolation = "23505"
+
+// isUniqueViolation reports whether err comes from a violation of one of the
+// given unique constraints.
+func isUniqueViolation(err error, constraints ...string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgUniqueViolation {
+		return false
+	}
+
+	for _, c := range constraints {
+		if pgErr.ConstraintName == c {
+			return true
+		}
+	}
+
+	return false
+}
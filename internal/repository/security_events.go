@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/repository/pgdb"
+	"github.com/netbill/restkit/pagi"
+)
+
+func (r Repository) CreateSecurityEvent(
+	ctx context.Context,
+	params account.CreateSecurityEventParams,
+) (models.SecurityEvent, error) {
+	row, err := r.securityEventsQ(ctx).Insert(ctx, pgdb.InsertSecurityEventParams{
+		AccountID: params.AccountID,
+		Type:      params.Type,
+		Method:    params.Method,
+		Reason:    params.Reason,
+		IP:        params.IP,
+		UserAgent: params.UserAgent,
+	})
+	if err != nil {
+		return models.SecurityEvent{}, fmt.Errorf(
+			"failed to insert security event for account %s, cause: %w", params.AccountID, err,
+		)
+	}
+
+	return row.ToModel(), nil
+}
+
+func (r Repository) GetSecurityEventsForAccount(
+	ctx context.Context,
+	accountID uuid.UUID,
+	limit, offset uint,
+) (pagi.Page[[]models.SecurityEvent], error) {
+	rows, err := r.securityEventsQ(ctx).
+		FilterAccountID(accountID).
+		OrderCreatedAt(false).
+		Page(limit, offset).
+		Select(ctx)
+	if err != nil {
+		return pagi.Page[[]models.SecurityEvent]{}, fmt.Errorf(
+			"failed to get security events for account %s, cause: %w", accountID, err,
+		)
+	}
+
+	total, err := r.securityEventsQ(ctx).FilterAccountID(accountID).Count(ctx)
+	if err != nil {
+		return pagi.Page[[]models.SecurityEvent]{}, fmt.Errorf(
+			"failed to count security events for account %s, cause: %w", accountID, err,
+		)
+	}
+
+	collection := make([]models.SecurityEvent, 0, len(rows))
+	for _, e := range rows {
+		collection = append(collection, e.ToModel())
+	}
+
+	return pagi.Page[[]models.SecurityEvent]{
+		Data:  collection,
+		Page:  uint(offset/limit) + 1,
+		Size:  uint(len(collection)),
+		Total: total,
+	}, nil
+}
+
+// GetLoginFailureIPs returns the distinct client IPs of failed logins of the
+// account made after since.
+func (r Repository) GetLoginFailureIPs(ctx context.Context, accountID uuid.UUID, since time.Time) ([]string, error) {
+	rows, err := r.securityEventsQ(ctx).
+		FilterAccountID(accountID).
+		FilterType(models.SecurityEventLoginFailed).
+		FilterCreatedAfter(since).
+		Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get failed logins of account %s, cause: %w", accountID, err)
+	}
+
+	seen := make(map[string]struct{}, len(rows))
+	ips := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if !row.IP.Valid {
+			continue
+		}
+		if _, ok := seen[row.IP.String]; ok {
+			continue
+		}
+		seen[row.IP.String] = struct{}{}
+		ips = append(ips, row.IP.String)
+	}
+
+	return ips, nil
+}
+
+func (r Repository) DeleteSecurityEventsBefore(ctx context.Context, before time.Time, limit uint) (uint, error) {
+	n, err := r.securityEventsQ(ctx).DeleteCreatedBefore(ctx, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete security events created before %s, cause: %w", before, err)
+	}
+
+	return n, nil
+}
@@ -4,20 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
 	"github.com/netbill/auth-svc/internal/repository/pgdb"
 	"github.com/netbill/restkit/pagi"
 )
 
-func (r Repository) CreateSession(ctx context.Context, sessionID, accountID uuid.UUID, hashToken string) (models.Session, error) {
+func (r Repository) CreateSession(ctx context.Context, params account.CreateSessionParams) (models.Session, error) {
 	row, err := r.sessionsQ(ctx).Insert(ctx, pgdb.InsertSessionParams{
-		ID:        sessionID,
-		AccountID: accountID,
-		HashToken: hashToken,
+		ID:         params.ID,
+		AccountID:  params.AccountID,
+		HashToken:  params.HashToken,
+		IP:         params.IP,
+		UserAgent:  params.UserAgent,
+		OS:         params.OS,
+		Browser:    params.Browser,
+		DeviceName: params.DeviceName,
 	})
 	if err != nil {
 		return models.Session{}, fmt.Errorf("failed to insert session, cause: %w", err)
@@ -91,6 +98,32 @@ func (r Repository) GetSessionsForAccount(ctx context.Context, userID uuid.UUID,
 	}, nil
 }
 
+func (r Repository) CountSessionsForAccount(ctx context.Context, accountID uuid.UUID) (uint, error) {
+	count, err := r.sessionsQ(ctx).FilterAccountID(accountID).Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count sessions for account %s, cause: %w", accountID, err)
+	}
+
+	return count, nil
+}
+
+func (r Repository) GetSessionsForAccountByLastUsed(ctx context.Context, accountID uuid.UUID) ([]models.Session, error) {
+	rows, err := r.sessionsQ(ctx).
+		FilterAccountID(accountID).
+		OrderLastUsed(true).
+		Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions for account %s, cause: %w", accountID, err)
+	}
+
+	sessions := make([]models.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, row.ToModel())
+	}
+
+	return sessions, nil
+}
+
 func (r Repository) GetSessionToken(ctx context.Context, sessionID uuid.UUID) (string, error) {
 	row, err := r.sessionsQ(ctx).FilterID(sessionID).Get(ctx)
 	switch {
@@ -109,6 +142,7 @@ func (r Repository) UpdateSessionToken(ctx context.Context, sessionID uuid.UUID,
 	sess, err := r.sessionsQ(ctx).
 		FilterID(sessionID).
 		UpdateToken(token).
+		UpdateLastUsed(time.Now()).
 		Update(ctx)
 	switch {
 	case errors.Is(err, pgx.ErrNoRows):
@@ -125,6 +159,126 @@ func (r Repository) UpdateSessionToken(ctx context.Context, sessionID uuid.UUID,
 	return sess[0].ToModel(), nil
 }
 
+// RefreshSession replaces the token of the session only while it still holds
+// oldToken, so of two refreshes with the same token only the first one wins.
+func (r Repository) RefreshSession(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	oldToken, token, ip string,
+) (models.Session, error) {
+	q := r.sessionsQ(ctx).
+		FilterID(sessionID).
+		FilterHashToken(oldToken).
+		UpdateToken(token).
+		UpdateLastUsed(time.Now())
+	if ip != "" {
+		q = q.UpdateIP(ip)
+	}
+
+	sess, err := q.Update(ctx)
+	if err != nil {
+		return models.Session{}, fmt.Errorf("failed to refresh session %s, cause: %w", sessionID, err)
+	}
+
+	if len(sess) != 1 {
+		return models.Session{}, errx.ErrorSessionTokenMismatch.Raise(
+			fmt.Errorf("refresh token of session %s has already been used", sessionID),
+		)
+	}
+	return sess[0].ToModel(), nil
+}
+
+func (r Repository) UpdateAccountSessionDeviceName(
+	ctx context.Context,
+	accountID, sessionID uuid.UUID,
+	name *string,
+) (models.Session, error) {
+	sess, err := r.sessionsQ(ctx).
+		FilterID(sessionID).
+		FilterAccountID(accountID).
+		UpdateDeviceName(name).
+		Update(ctx)
+	if err != nil {
+		return models.Session{}, fmt.Errorf(
+			"failed to update device name for session %s, cause: %w", sessionID, err,
+		)
+	}
+
+	if len(sess) != 1 {
+		return models.Session{}, errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("session with id %s not found for account %s", sessionID, accountID),
+		)
+	}
+	return sess[0].ToModel(), nil
+}
+
+func (r Repository) UpdateSessionsLastUsed(ctx context.Context, lastUsed map[uuid.UUID]time.Time) error {
+	_, err := r.sessionsQ(ctx).TouchMany(ctx, lastUsed)
+	if err != nil {
+		return fmt.Errorf("failed to update last used for %d sessions, cause: %w", len(lastUsed), err)
+	}
+
+	return nil
+}
+
+func (r Repository) UpdateSessionActiveOrganization(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	organizationID *uuid.UUID,
+) (models.Session, error) {
+	sess, err := r.sessionsQ(ctx).
+		FilterID(sessionID).
+		UpdateActiveOrganization(organizationID).
+		UpdateLastUsed(time.Now()).
+		Update(ctx)
+	if err != nil {
+		return models.Session{}, fmt.Errorf(
+			"failed to update active organization for session %s, cause: %w", sessionID, err,
+		)
+	}
+
+	if len(sess) != 1 {
+		return models.Session{}, errx.ErrorSessionNotFound.Raise(
+			fmt.Errorf("session with id %s not found", sessionID),
+		)
+	}
+	return sess[0].ToModel(), nil
+}
+
+func (r Repository) DeleteExpiredSessions(
+	ctx context.Context,
+	filter account.ExpiredSessionsFilter,
+	limit uint,
+) ([]models.Session, error) {
+	rows, err := r.sessionsQ(ctx).
+		FilterAccountRoles(filter.ExcludeRoles, filter.Roles...).
+		FilterExpired(filter.IdleBefore, filter.CreatedBefore).
+		Page(limit, 0).
+		ForUpdateSkipLocked().
+		Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select expired sessions, cause: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	sessions := make([]models.Session, 0, len(rows))
+	for _, row := range rows {
+		sess := row.ToModel()
+		ids = append(ids, sess.ID)
+		sessions = append(sessions, sess)
+	}
+
+	err = r.sessionsQ(ctx).FilterIDs(ids...).Delete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete %d expired sessions, cause: %w", len(ids), err)
+	}
+
+	return sessions, nil
+}
+
 func (r Repository) DeleteSession(ctx context.Context, sessionID uuid.UUID) error {
 	err := r.sessionsQ(ctx).FilterID(sessionID).Delete(ctx)
 	if err != nil {
@@ -134,6 +288,19 @@ func (r Repository) DeleteSession(ctx context.Context, sessionID uuid.UUID) erro
 	return nil
 }
 
+func (r Repository) DeleteSessions(ctx context.Context, sessionIDs ...uuid.UUID) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	err := r.sessionsQ(ctx).FilterIDs(sessionIDs...).Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete %d sessions, cause: %w", len(sessionIDs), err)
+	}
+
+	return nil
+}
+
 func (r Repository) DeleteSessionsForAccount(ctx context.Context, userID uuid.UUID) error {
 	err := r.sessionsQ(ctx).FilterAccountID(userID).Delete(ctx)
 	if err != nil {
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/repository/pgdb"
+)
+
+// AddUsernameHistory remembers a username the account gave up.
+func (r Repository) AddUsernameHistory(
+	ctx context.Context,
+	accountID uuid.UUID,
+	username, skeleton string,
+	changedAt time.Time,
+) (models.UsernameChange, error) {
+	row, err := r.usernameHistoryQ(ctx).Insert(ctx, pgdb.InsertUsernameHistoryParams{
+		AccountID:        accountID,
+		Username:         username,
+		UsernameSkeleton: skeleton,
+		ChangedAt:        changedAt,
+	})
+	if err != nil {
+		return models.UsernameChange{}, fmt.Errorf(
+			"failed to add username history for account %s, cause: %w", accountID, err,
+		)
+	}
+
+	return row.ToModel(), nil
+}
+
+func (r Repository) GetLastUsernameChange(ctx context.Context, accountID uuid.UUID) (models.UsernameChange, error) {
+	row, err := r.usernameHistoryQ(ctx).
+		FilterAccountID(accountID).
+		OrderChangedAt(false).
+		Get(ctx)
+	switch {
+	case err != nil:
+		return models.UsernameChange{}, fmt.Errorf(
+			"failed to get last username change of account %s, cause: %w", accountID, err,
+		)
+	case !row.ID.Valid:
+		return models.UsernameChange{}, errx.ErrorUsernameChangeNotFound.Raise(
+			fmt.Errorf("account %s never changed its username", accountID),
+		)
+	}
+
+	return row.ToModel(), nil
+}
+
+// GetLastUsernameChangeByUsername returns the most recent release of the
+// username by any account.
+func (r Repository) GetLastUsernameChangeByUsername(ctx context.Context, username string) (models.UsernameChange, error) {
+	row, err := r.usernameHistoryQ(ctx).
+		FilterUsername(username).
+		OrderChangedAt(false).
+		Get(ctx)
+	switch {
+	case err != nil:
+		return models.UsernameChange{}, fmt.Errorf("failed to get username change of %s, cause: %w", username, err)
+	case !row.ID.Valid:
+		return models.UsernameChange{}, errx.ErrorUsernameChangeNotFound.Raise(
+			fmt.Errorf("username %s was never given up", username),
+		)
+	}
+
+	return row.ToModel(), nil
+}
+
+// ExistsUsernameReleasedSince tells whether an account other than the given
+// one gave up a username with the skeleton after the given moment.
+func (r Repository) ExistsUsernameReleasedSince(
+	ctx context.Context,
+	skeleton string,
+	exceptAccountID uuid.UUID,
+	since time.Time,
+) (bool, error) {
+	exists, err := r.usernameHistoryQ(ctx).
+		FilterUsernameSkeleton(skeleton).
+		FilterNotAccountID(exceptAccountID).
+		FilterChangedAfter(since).
+		Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check released usernames, cause: %w", err)
+	}
+
+	return exists, nil
+}
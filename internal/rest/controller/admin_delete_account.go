@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/netbill/ape"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/core/errx"
+)
+
+func (s *Service) AdminDeleteAccount(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(chi.URLParam(r, "account_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid account id: %s", chi.URLParam(r, "account_id"))
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid account id: %s", chi.URLParam(r, "account_id")),
+		})...)
+
+		return
+	}
+
+	err = s.core.DeleteAccount(r.Context(), accountID)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to delete account %s", accountID)
+		switch {
+		case errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.NotFound("account not found"))
+		case errors.Is(err, errx.AccountHaveMembershipInOrg):
+			ape.RenderErr(w, problems.Forbidden("account cannot be deleted while having membership in organization"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("account %s deleted by admin", accountID)
+
+	ape.Render(w, http.StatusNoContent)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/netbill/ape"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/rest/requests"
+	"github.com/netbill/auth-svc/internal/rest/responses"
+	"github.com/netbill/restkit/pagi"
+)
+
+func (s *Service) AdminGetAccounts(w http.ResponseWriter, r *http.Request) {
+	filter, err := requests.FilterAccounts(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to parse accounts filter")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	limit, offset := pagi.GetPagination(r)
+	accounts, err := s.core.FilterAccounts(r.Context(), filter, limit, offset)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to filter accounts")
+		ape.RenderErr(w, problems.InternalError())
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.AccountsCollection(r, accounts))
+}
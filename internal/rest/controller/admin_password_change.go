@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/netbill/ape"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/core/errx"
+)
+
+func (s *Service) AdminRequirePasswordChange(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(chi.URLParam(r, "account_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid account id: %s", chi.URLParam(r, "account_id"))
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid account id: %s", chi.URLParam(r, "account_id")),
+		})...)
+
+		return
+	}
+
+	err = s.core.RequireAccountPasswordChange(r.Context(), accountID)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to require password change of account %s", accountID)
+		switch {
+		case errors.Is(err, errx.ErrorAccountNotFound), errors.Is(err, errx.ErrorAccountPasswordNorFound):
+			ape.RenderErr(w, problems.NotFound("account not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("password change of account %s required by admin", accountID)
+
+	ape.Render(w, http.StatusNoContent)
+}
+
+func (s *Service) AdminCancelPasswordChange(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(chi.URLParam(r, "account_id"))
+	if err != nil {
+		s.log.WithError(err).Errorf("invalid account id: %s", chi.URLParam(r, "account_id"))
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("invalid account id: %s", chi.URLParam(r, "account_id")),
+		})...)
+
+		return
+	}
+
+	err = s.core.CancelAccountPasswordChange(r.Context(), accountID)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to cancel password change of account %s", accountID)
+		switch {
+		case errors.Is(err, errx.ErrorAccountNotFound), errors.Is(err, errx.ErrorAccountPasswordNorFound):
+			ape.RenderErr(w, problems.NotFound("account not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("password change requirement of account %s cancelled by admin", accountID)
+
+	ape.Render(w, http.StatusNoContent)
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/netbill/ape"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/rest/requests"
+	"github.com/netbill/auth-svc/internal/rest/responses"
+)
+
+func (s *Service) AdminUpdateAccountRole(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.UpdateAccountRole(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode update account role request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	acc, err := s.core.UpdateAccountRole(r.Context(), req.Data.Id, req.Data.Attributes.Role)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to update role of account %s", req.Data.Id)
+		switch {
+		case errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.NotFound("account not found"))
+		case errors.Is(err, errx.ErrorRoleNotSupported):
+			ape.RenderErr(w, problems.BadRequest(err)...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("role of account %s updated to %s", acc.ID, acc.Role)
+
+	ape.Render(w, http.StatusOK, responses.Account(acc))
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/netbill/ape"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/rest/requests"
+	"github.com/netbill/auth-svc/internal/rest/responses"
+)
+
+func (s *Service) AdminUpdateAccountStatus(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.UpdateAccountStatus(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode update account status request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	acc, err := s.core.UpdateAccountStatus(r.Context(), req.Data.Id, account.UpdateAccountStatusParams{
+		Status: req.Data.Attributes.Status,
+		Reason: req.Data.Attributes.Reason,
+		Until:  req.Data.Attributes.Until,
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to update status of account %s", req.Data.Id)
+		switch {
+		case errors.Is(err, errx.ErrorAccountNotFound):
+			ape.RenderErr(w, problems.NotFound("account not found"))
+		case errors.Is(err, errx.ErrorAccountStatusNotSupported) ||
+			errors.Is(err, errx.ErrorAccountStatusUntilInvalid):
+			ape.RenderErr(w, problems.BadRequest(err)...)
+		case errors.Is(err, errx.ErrorAccountPendingDeletion):
+			ape.RenderErr(w, problems.Conflict("account deletion is waiting for removal of its memberships"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("status of account %s updated to %s", acc.ID, acc.Status)
+
+	ape.Render(w, http.StatusOK, responses.Account(acc))
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/rest/middlewares"
+)
+
+func clientData(r *http.Request, deviceName *string) account.ClientData {
+	ip := middlewares.ClientIP(r.Context())
+	if ip == "" {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		ip = host
+	}
+
+	return account.ClientData{
+		IP:         ip,
+		UserAgent:  r.UserAgent(),
+		DeviceName: deviceName,
+	}
+}
@@ -44,6 +44,8 @@ func (s *Service) DeleteMySession(w http.ResponseWriter, r *http.Request) {
 			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
 		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
 			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorSessionNotFound):
+			ape.RenderErr(w, problems.NotFound("session not found"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
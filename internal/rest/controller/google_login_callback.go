@@ -61,12 +61,20 @@ func (s *Service) LoginByGoogleOAuthCallback(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	tokensPair, err := s.core.LoginByGoogle(r.Context(), userInfo.Email)
+	tokensPair, err := s.core.LoginByGoogle(r.Context(), userInfo.Email, clientData(r, nil))
 	if err != nil {
 		s.log.WithError(err).Errorf("error logging in user: %s", userInfo.Email)
+		if problem := accountStatusProblem(err); problem != nil {
+			ape.RenderErr(w, problem)
+
+			return
+		}
+
 		switch {
 		case errors.Is(err, errx.ErrorAccountNotFound):
 			ape.RenderErr(w, problems.NotFound("user with this email not found"))
+		case errors.Is(err, errx.ErrorSessionLimitReached):
+			ape.RenderErr(w, problems.Forbidden("active sessions limit reached, log out from another device"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
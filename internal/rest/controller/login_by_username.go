@@ -20,12 +20,30 @@ func (s *Service) LoginByUsername(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := s.core.LoginByUsername(r.Context(), req.Data.Attributes.Username, req.Data.Attributes.Password)
+	token, err := s.core.LoginByUsername(
+		r.Context(),
+		req.Data.Attributes.Username,
+		req.Data.Attributes.Password,
+		clientData(r, req.Data.Attributes.DeviceName),
+	)
 	if err != nil {
 		s.log.WithError(err).Errorf("failed to login user")
+		if problem := loginLockedProblem(w, err); problem != nil {
+			ape.RenderErr(w, problem)
+
+			return
+		}
+		if problem := accountStatusProblem(err); problem != nil {
+			ape.RenderErr(w, problem)
+
+			return
+		}
+
 		switch {
 		case errors.Is(err, errx.ErrorPasswordInvalid) || errors.Is(err, errx.ErrorAccountNotFound):
 			ape.RenderErr(w, problems.Unauthorized("invalid login or password"))
+		case errors.Is(err, errx.ErrorSessionLimitReached):
+			ape.RenderErr(w, problems.Forbidden("active sessions limit reached, log out from another device"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
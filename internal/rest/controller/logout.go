@@ -1,10 +1,12 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/netbill/ape"
 	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/core/errx"
 	"github.com/netbill/auth-svc/internal/core/modules/account"
 	"github.com/netbill/auth-svc/internal/rest/middlewares"
 )
@@ -25,6 +27,8 @@ func (s *Service) Logout(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		s.log.WithError(err).Errorf("failed to logout user")
 		switch {
+		case errors.Is(err, errx.ErrorSessionNotFound):
+			ape.RenderErr(w, problems.Unauthorized("session not found"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/jsonapi"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+)
+
+// accountStatusProblem describes why an account that is not active can not
+// sign in, the code lets clients tell a suspension from a ban. It returns nil
+// for errors not related to the account status.
+func accountStatusProblem(err error) *jsonapi.ErrorObject {
+	var code, detail string
+
+	switch {
+	case errors.Is(err, errx.ErrorAccountSuspended):
+		code, detail = "ACCOUNT_SUSPENDED", "account is suspended"
+	case errors.Is(err, errx.ErrorAccountBanned):
+		code, detail = "ACCOUNT_BANNED", "account is banned"
+	case errors.Is(err, errx.ErrorAccountPendingDeletion):
+		code, detail = "ACCOUNT_PENDING_DELETION", "account is pending deletion"
+	default:
+		return nil
+	}
+
+	problem := problems.Forbidden(detail)
+	problem.Code = code
+
+	return problem
+}
+
+// loginLockedProblem describes a login refused after too many failures and
+// sets the Retry-After header. It returns nil for any other error.
+func loginLockedProblem(w http.ResponseWriter, err error) *jsonapi.ErrorObject {
+	var locked *account.LoginLockedError
+	if !errors.As(err, &locked) {
+		return nil
+	}
+
+	retryAfter := int64(math.Ceil(locked.RetryAfter(time.Now().UTC()).Seconds()))
+	w.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+
+	return &jsonapi.ErrorObject{
+		Title:  http.StatusText(http.StatusTooManyRequests),
+		Status: fmt.Sprintf("%d", http.StatusTooManyRequests),
+		Code:   "ACCOUNT_TEMPORARILY_LOCKED",
+		Detail: "too many failed login attempts, try again later",
+		Meta: &map[string]interface{}{
+			"retry_after": retryAfter,
+		},
+	}
+}
+
+// passwordPolicyProblems renders one error per broken password rule, so
+// clients can show all of them at once.
+func passwordPolicyProblems(err error) []*jsonapi.ErrorObject {
+	var policyErr *account.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		return problems.BadRequest(validation.Errors{
+			"repo/attributes/password": err,
+		})
+	}
+
+	out := make([]*jsonapi.ErrorObject, 0, len(policyErr.Violations))
+	for _, v := range policyErr.Violations {
+		meta := map[string]interface{}{
+			"field": "password",
+			"rule":  v.Rule,
+		}
+		if v.Limit > 0 {
+			meta["limit"] = v.Limit
+		}
+		if v.Characters != "" {
+			meta["characters"] = v.Characters
+		}
+		if v.Symbols != "" {
+			meta["symbols"] = v.Symbols
+		}
+
+		out = append(out, &jsonapi.ErrorObject{
+			Title:  http.StatusText(http.StatusBadRequest),
+			Status: fmt.Sprintf("%d", http.StatusBadRequest),
+			Code:   "PASSWORD_POLICY_VIOLATION",
+			Detail: passwordViolationDetail(v),
+			Meta:   &meta,
+		})
+	}
+
+	return out
+}
+
+func passwordViolationDetail(v models.PasswordViolation) string {
+	switch v.Rule {
+	case models.PasswordRuleMinLength:
+		return fmt.Sprintf("password must be at least %d characters long", v.Limit)
+	case models.PasswordRuleMaxLength:
+		return fmt.Sprintf("password must be at most %d characters long", v.Limit)
+	case models.PasswordRuleUppercase:
+		return "password must contain an uppercase letter"
+	case models.PasswordRuleLowercase:
+		return "password must contain a lowercase letter"
+	case models.PasswordRuleDigit:
+		return "password must contain a digit"
+	case models.PasswordRuleSymbol:
+		if v.Symbols != "" {
+			return fmt.Sprintf("password must contain one of the symbols %s", v.Symbols)
+		}
+		return "password must contain a symbol"
+	case models.PasswordRuleInvalidCharacter:
+		return "password contains characters that are not allowed"
+	case models.PasswordRuleRecentlyUsed:
+		return fmt.Sprintf("password must differ from the last %d passwords", v.Limit)
+	case models.PasswordRuleBreached:
+		return "password has appeared in a data breach, choose another one"
+	default:
+		return "password does not meet the requirements"
+	}
+}
@@ -20,16 +20,28 @@ func (s *Service) RefreshSession(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tokensPair, err := s.core.Refresh(r.Context(), req.Data.Attributes.RefreshToken)
+	tokensPair, err := s.core.Refresh(r.Context(), req.Data.Attributes.RefreshToken, clientData(r, nil))
 	if err != nil {
 		s.log.WithError(err).Errorf("failed to refresh session token")
+		if problem := accountStatusProblem(err); problem != nil {
+			ape.RenderErr(w, problem)
+
+			return
+		}
+
 		switch {
 		case errors.Is(err, errx.ErrorAccountNotFound):
 			ape.RenderErr(w, problems.Unauthorized("account not found"))
 		case errors.Is(err, errx.ErrorSessionNotFound):
 			ape.RenderErr(w, problems.Unauthorized("session not found"))
+		case errors.Is(err, errx.ErrorSessionExpired):
+			ape.RenderErr(w, problems.Unauthorized("session expired"))
 		case errors.Is(err, errx.ErrorSessionTokenMismatch):
 			ape.RenderErr(w, problems.Forbidden("refresh session token mismatch"))
+		case errors.Is(err, errx.ErrorPasswordChangeRequired):
+			problem := problems.Forbidden("password has to be changed before refreshing the session")
+			problem.Code = "PASSWORD_CHANGE_REQUIRED"
+			ape.RenderErr(w, problem)
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
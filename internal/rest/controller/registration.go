@@ -41,9 +41,7 @@ func (s *Service) Registration(w http.ResponseWriter, r *http.Request) {
 				"repo/attributes/username": err,
 			})...)
 		case errors.Is(err, errx.ErrorPasswordIsNotAllowed):
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
-				"repo/attributes/password": err,
-			})...)
+			ape.RenderErr(w, passwordPolicyProblems(err)...)
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
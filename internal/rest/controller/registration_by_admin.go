@@ -55,9 +55,7 @@ func (s *Service) RegistrationByAdmin(w http.ResponseWriter, r *http.Request) {
 				"repo/attributes/username": err,
 			})...)
 		case errors.Is(err, errx.ErrorPasswordIsNotAllowed):
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
-				"repo/attributes/password": err,
-			})...)
+			ape.RenderErr(w, passwordPolicyProblems(err)...)
 		case errors.Is(err, errx.ErrorRoleNotSupported):
 			ape.RenderErr(w, problems.BadRequest(validation.Errors{
 				"repo/attributes/role": err,
@@ -17,11 +17,24 @@ type core interface {
 		params account.RegistrationParams,
 	) (models.Account, error)
 
-	LoginByEmail(ctx context.Context, email, password string) (models.TokensPair, error)
-	LoginByGoogle(ctx context.Context, email string) (models.TokensPair, error)
-	LoginByUsername(ctx context.Context, username, password string) (models.TokensPair, error)
+	LoginByEmail(
+		ctx context.Context,
+		email, password string,
+		client account.ClientData,
+	) (models.TokensPair, error)
+	LoginByGoogle(ctx context.Context, email string, client account.ClientData) (models.TokensPair, error)
+	LoginByUsername(
+		ctx context.Context,
+		username, password string,
+		client account.ClientData,
+	) (models.TokensPair, error)
 
-	Refresh(ctx context.Context, oldRefreshToken string) (models.TokensPair, error)
+	Refresh(ctx context.Context, oldRefreshToken string, client account.ClientData) (models.TokensPair, error)
+	SwitchActiveOrganization(
+		ctx context.Context,
+		initiator account.InitiatorData,
+		organizationID *uuid.UUID,
+	) (models.TokensPair, error)
 
 	UpdatePassword(
 		ctx context.Context,
@@ -34,7 +47,21 @@ type core interface {
 		newUsername string,
 	) (account models.Account, err error)
 
+	UpdateAccountRole(ctx context.Context, accountID uuid.UUID, role string) (models.Account, error)
+	UpdateAccountStatus(
+		ctx context.Context,
+		accountID uuid.UUID,
+		params account.UpdateAccountStatusParams,
+	) (models.Account, error)
+
 	GetAccountByID(ctx context.Context, ID uuid.UUID) (models.Account, error)
+	LookupUsername(ctx context.Context, username string) (models.UsernameLookup, error)
+	GetAccountDetails(ctx context.Context, ID uuid.UUID) (models.AccountDetails, error)
+	FilterAccounts(
+		ctx context.Context,
+		filter account.AccountsFilter,
+		limit, offset uint,
+	) (pagi.Page[[]models.Account], error)
 	GetAccountEmail(ctx context.Context, ID uuid.UUID) (models.AccountEmail, error)
 
 	GetOwnSession(ctx context.Context, initiator account.InitiatorData, sessionID uuid.UUID) (models.Session, error)
@@ -43,12 +70,29 @@ type core interface {
 		initiator account.InitiatorData,
 		limit, offset uint,
 	) (pagi.Page[[]models.Session], error)
+	UpdateOwnSessionDeviceName(
+		ctx context.Context,
+		initiator account.InitiatorData,
+		sessionID uuid.UUID,
+		deviceName *string,
+	) (models.Session, error)
 
 	DeleteOwnAccount(ctx context.Context, initiator account.InitiatorData) error
+	DeleteAccount(ctx context.Context, accountID uuid.UUID) error
 
 	Logout(ctx context.Context, initiator account.InitiatorData) error
 	DeleteOwnSession(ctx context.Context, initiator account.InitiatorData, sessionID uuid.UUID) error
 	DeleteOwnSessions(ctx context.Context, initiator account.InitiatorData) error
+	GetOwnSecurityEvents(
+		ctx context.Context,
+		initiator account.InitiatorData,
+		limit, offset uint,
+	) (pagi.Page[[]models.SecurityEvent], error)
+
+	ClearAccountLoginLockout(ctx context.Context, accountID uuid.UUID) error
+	RequireAccountPasswordChange(ctx context.Context, accountID uuid.UUID) error
+	CancelAccountPasswordChange(ctx context.Context, accountID uuid.UUID) error
+	RevokeAccountSessions(ctx context.Context, accountID uuid.UUID) error
 }
 
 type Service struct {
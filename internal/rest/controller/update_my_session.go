@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/netbill/ape"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/auth-svc/internal/core/errx"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+	"github.com/netbill/auth-svc/internal/rest/middlewares"
+	"github.com/netbill/auth-svc/internal/rest/requests"
+	"github.com/netbill/auth-svc/internal/rest/responses"
+)
+
+func (s *Service) UpdateMySession(w http.ResponseWriter, r *http.Request) {
+	initiator, err := middlewares.AccountData(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	req, err := requests.UpdateAccountSession(r)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode update session request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	session, err := s.core.UpdateOwnSessionDeviceName(r.Context(), account.InitiatorData{
+		AccountID: initiator.AccountID,
+		SessionID: initiator.SessionID,
+	}, req.Data.Id, req.Data.Attributes.DeviceName)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to update my session")
+		switch {
+		case errors.Is(err, errx.ErrorInitiatorNotFound):
+			ape.RenderErr(w, problems.Unauthorized("initiator account not found by credentials"))
+		case errors.Is(err, errx.ErrorInitiatorInvalidSession):
+			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
+		case errors.Is(err, errx.ErrorSessionNotFound):
+			ape.RenderErr(w, problems.NotFound("session not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.AccountSession(session))
+}
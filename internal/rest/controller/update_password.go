@@ -10,8 +10,6 @@ import (
 	"github.com/netbill/auth-svc/internal/core/modules/account"
 	"github.com/netbill/auth-svc/internal/rest/middlewares"
 	"github.com/netbill/auth-svc/internal/rest/requests"
-
-	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
 func (s *Service) UpdatePassword(w http.ResponseWriter, r *http.Request) {
@@ -47,9 +45,7 @@ func (s *Service) UpdatePassword(w http.ResponseWriter, r *http.Request) {
 		case errors.Is(err, errx.ErrorCannotChangePasswordYet):
 			ape.RenderErr(w, problems.Forbidden("cannot change password yet"))
 		case errors.Is(err, errx.ErrorPasswordIsNotAllowed):
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
-				"repo/attributes/password": err,
-			})...)
+			ape.RenderErr(w, passwordPolicyProblems(err)...)
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
@@ -45,6 +45,8 @@ func (s *Service) UpdateUsername(w http.ResponseWriter, r *http.Request) {
 			ape.RenderErr(w, problems.Unauthorized("initiator session is invalid"))
 		case errors.Is(err, errx.ErrorPasswordInvalid):
 			ape.RenderErr(w, problems.Unauthorized("invalid password"))
+		case errors.Is(err, errx.ErrorCannotChangeUsernameYet):
+			ape.RenderErr(w, problems.Forbidden("cannot change username yet"))
 		case errors.Is(err, errx.ErrorUsernameAlreadyTaken):
 			ape.RenderErr(w, problems.Conflict("user with this username already exists"))
 		case errors.Is(err, errx.ErrorUsernameIsNotAllowed):
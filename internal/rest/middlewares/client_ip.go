@@ -0,0 +1,92 @@
+package middlewares
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// trustedProxies holds the networks allowed to tell the client address with
+// X-Forwarded-For, the header is ignored for requests coming from anywhere else.
+type trustedProxies []netip.Prefix
+
+func parseTrustedProxies(values []string) (trustedProxies, []string) {
+	var (
+		out     trustedProxies
+		invalid []string
+	)
+
+	for _, val := range values {
+		val = strings.TrimSpace(val)
+		if prefix, err := netip.ParsePrefix(val); err == nil {
+			out = append(out, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(val); err == nil {
+			out = append(out, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		invalid = append(invalid, val)
+	}
+
+	return out, invalid
+}
+
+func (t trustedProxies) contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolve returns the address of the client that made the request. Entries
+// of X-Forwarded-For are read right to left while they belong to trusted
+// proxies, so a client can not spoof its address by sending the header itself.
+func (t trustedProxies) resolve(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	remote, err := netip.ParseAddr(host)
+	if err != nil || !t.contains(remote) {
+		return host
+	}
+
+	hops := make([]string, 0)
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(header, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+
+	client := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(hops[i])
+		if err != nil {
+			break
+		}
+
+		client = addr.Unmap()
+		if !t.contains(client) {
+			break
+		}
+	}
+
+	return client.String()
+}
+
+func (s Service) ClientIP() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), clientIPCtxKey, s.proxies.resolve(r))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestTrustedProxiesResolve(t *testing.T) {
+	proxies, invalid := parseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1", "2001:db8::/32"})
+	if len(invalid) > 0 {
+		t.Fatalf("parseTrustedProxies() rejected %v", invalid)
+	}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name:       "direct client",
+			remoteAddr: "203.0.113.7:51234",
+			want:       "203.0.113.7",
+		},
+		{
+			name:         "header from untrusted client is ignored",
+			remoteAddr:   "203.0.113.7:51234",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy without header",
+			remoteAddr: "10.0.0.2:8080",
+			want:       "10.0.0.2",
+		},
+		{
+			name:         "client behind one proxy",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "spoofed entries left of the client are ignored",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"1.1.1.1, 198.51.100.1"},
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "chain of trusted proxies",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"1.1.1.1, 198.51.100.1, 192.168.1.1, 10.1.2.3"},
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "entries split across headers",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"1.1.1.1, 198.51.100.1", "10.1.2.3"},
+			want:         "198.51.100.1",
+		},
+		{
+			name:         "only trusted proxies in the chain",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"10.1.2.3, 192.168.1.1"},
+			want:         "10.1.2.3",
+		},
+		{
+			name:         "malformed entry stops the walk",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"198.51.100.1, not-an-ip, 10.1.2.3"},
+			want:         "10.1.2.3",
+		},
+		{
+			name:         "ipv6 client behind ipv6 proxy",
+			remoteAddr:   "[2001:db8::1]:443",
+			forwardedFor: []string{"2001:db9::5"},
+			want:         "2001:db9::5",
+		},
+		{
+			name:         "ipv4-mapped client is unmapped",
+			remoteAddr:   "10.0.0.2:8080",
+			forwardedFor: []string{"::ffff:198.51.100.1"},
+			want:         "198.51.100.1",
+		},
+		{
+			name:       "remote address without port",
+			remoteAddr: "203.0.113.7",
+			want:       "203.0.113.7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := http.NewRequest(http.MethodGet, "/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.RemoteAddr = tt.remoteAddr
+			for _, val := range tt.forwardedFor {
+				r.Header.Add("X-Forwarded-For", val)
+			}
+
+			if got := proxies.resolve(r); got != tt.want {
+				t.Errorf("resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 const (
 	accountDataCtxKey = iota
+	clientIPCtxKey
 )
 
 func AccountData(ctx context.Context) (tokens.AccountJwtData, error) {
@@ -23,3 +24,14 @@ func AccountData(ctx context.Context) (tokens.AccountJwtData, error) {
 
 	return userData, nil
 }
+
+// ClientIP is the client address resolved by the ClientIP middleware, empty
+// when the middleware did not run.
+func ClientIP(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	ip, _ := ctx.Value(clientIPCtxKey).(string)
+	return ip
+}
@@ -1,34 +1,75 @@
 package middlewares
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/netbill/logium"
 	"github.com/netbill/restkit/mdlv"
 )
 
 type Service struct {
-	accountAccessSK string
+	accountAccessSK  string
+	passwordChangeSK string
+
+	activity *sessionActivity
+	proxies  trustedProxies
 
 	log *logium.Logger
 }
 
 type Config struct {
-	AccountAccessSK string
+	AccountAccessSK  string
+	PasswordChangeSK string
+
+	SessionActivityFlushInterval time.Duration
+	SessionActivityMaxPending    int
+
+	// TrustedProxies are addresses or CIDR networks of proxies whose
+	// X-Forwarded-For header is trusted to tell the client IP.
+	TrustedProxies []string
 }
 
 func New(
 	log *logium.Logger,
-	accountAccessSK string,
+	cfg Config,
+	sessions sessionsToucher,
 ) Service {
+	proxies, invalid := parseTrustedProxies(cfg.TrustedProxies)
+	for _, val := range invalid {
+		log.Warnf("ignoring invalid trusted proxy %q", val)
+	}
+
 	return Service{
-		accountAccessSK: accountAccessSK,
-		log:             log,
+		accountAccessSK:  cfg.AccountAccessSK,
+		passwordChangeSK: cfg.PasswordChangeSK,
+		activity: newSessionActivity(
+			log, sessions, cfg.SessionActivityFlushInterval, cfg.SessionActivityMaxPending,
+		),
+		proxies: proxies,
+		log:     log,
 	}
 }
 
+// RunSessionActivity flushes coalesced session usage collected by AccountAuth
+// until ctx is done, then flushes what is left.
+func (s Service) RunSessionActivity(ctx context.Context) {
+	s.activity.run(ctx)
+}
+
 func (s Service) AccountAuth() func(next http.Handler) http.Handler {
-	return mdlv.AccountAuth(s.log, accountDataCtxKey, s.accountAccessSK)
+	auth := mdlv.AccountAuth(s.log, accountDataCtxKey, s.accountAccessSK)
+
+	return func(next http.Handler) http.Handler {
+		return auth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if data, err := AccountData(r.Context()); err == nil {
+				s.activity.touch(data.SessionID, time.Now().UTC())
+			}
+
+			next.ServeHTTP(w, r)
+		}))
+	}
 }
 
 func (s Service) AccountRoleGrant(
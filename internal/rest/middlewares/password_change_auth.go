@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/netbill/ape"
+	"github.com/netbill/ape/problems"
+	"github.com/netbill/restkit/tokens"
+)
+
+// PasswordChangeAuth authenticates like AccountAuth but also accepts the
+// restricted tokens issued while an account has to change its password.
+func (s Service) PasswordChangeAuth() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tkn, ok := bearerToken(r)
+			if !ok {
+				ape.RenderErr(w, problems.Unauthorized("missing bearer token"))
+				return
+			}
+
+			data, err := tokens.ParseAccountJWT(tkn, s.accountAccessSK)
+			if err != nil && s.passwordChangeSK != "" {
+				data, err = tokens.ParseAccountJWT(tkn, s.passwordChangeSK)
+			}
+			if err != nil {
+				s.log.WithError(err).Warn("failed to parse password change token")
+				ape.RenderErr(w, problems.Unauthorized("invalid token"))
+				return
+			}
+
+			s.activity.touch(data.SessionID, time.Now().UTC())
+
+			ctx := context.WithValue(r.Context(), accountDataCtxKey, data)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, tkn, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(tkn) == "" {
+		return "", false
+	}
+
+	return strings.TrimSpace(tkn), true
+}
@@ -0,0 +1,134 @@
+package middlewares
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/jsonapi"
+	"github.com/netbill/ape"
+)
+
+const rateLimitSweepInterval = time.Minute
+
+// RateLimit allows Requests per Period for every client with bursts up to
+// Burst, zero Requests disables the limit.
+type RateLimit struct {
+	Requests uint
+	Period   time.Duration
+	Burst    uint
+	// ByAccount keys authenticated requests by the account instead of the
+	// client IP, it needs AccountAuth to run before the limiter.
+	ByAccount bool
+}
+
+func (l RateLimit) Enabled() bool {
+	return l.Requests > 0 && l.Period > 0
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is an in-memory token bucket per key. Buckets refilled up to
+// the burst are dropped from time to time, they are no different from new ones.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	burst := limit.Burst
+	if burst == 0 {
+		burst = limit.Requests
+	}
+
+	return &rateLimiter{
+		rate:    float64(limit.Requests) / limit.Period.Seconds(),
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allow takes a token for the key and returns how long to wait when there is none.
+func (l *rateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// RateLimit limits requests of the route group, each group counts its own requests.
+func (s Service) RateLimit(group string, limit RateLimit) func(next http.Handler) http.Handler {
+	if !limit.Enabled() {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	limiter := newRateLimiter(limit)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := "ip:" + ClientIP(r.Context())
+			if limit.ByAccount {
+				if data, err := AccountData(r.Context()); err == nil {
+					key = "account:" + data.AccountID.String()
+				}
+			}
+
+			ok, wait := limiter.allow(key, time.Now())
+			if ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			retryAfter := int64(math.Ceil(wait.Seconds()))
+			s.log.Warnf("rate limit of %s exceeded by %s, retry after %ds", group, key, retryAfter)
+
+			w.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+			ape.RenderErr(w, &jsonapi.ErrorObject{
+				Title:  http.StatusText(http.StatusTooManyRequests),
+				Status: fmt.Sprintf("%d", http.StatusTooManyRequests),
+				Code:   "TOO_MANY_REQUESTS",
+				Detail: "too many requests, try again later",
+				Meta: &map[string]interface{}{
+					"retry_after": retryAfter,
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package middlewares
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	type step struct {
+		key   string
+		after time.Duration
+		ok    bool
+		wait  time.Duration
+	}
+
+	tests := []struct {
+		name  string
+		limit RateLimit
+		steps []step
+	}{
+		{
+			name:  "burst defaults to requests",
+			limit: RateLimit{Requests: 2, Period: time.Second},
+			steps: []step{
+				{key: "a", ok: true},
+				{key: "a", ok: true},
+				{key: "a", ok: false, wait: 500 * time.Millisecond},
+			},
+		},
+		{
+			name:  "burst above requests",
+			limit: RateLimit{Requests: 1, Period: time.Second, Burst: 3},
+			steps: []step{
+				{key: "a", ok: true},
+				{key: "a", ok: true},
+				{key: "a", ok: true},
+				{key: "a", ok: false, wait: time.Second},
+			},
+		},
+		{
+			name:  "refills over time",
+			limit: RateLimit{Requests: 1, Period: time.Minute},
+			steps: []step{
+				{key: "a", ok: true},
+				{key: "a", after: 30 * time.Second, ok: false, wait: 30 * time.Second},
+				{key: "a", after: 30 * time.Second, ok: true},
+			},
+		},
+		{
+			name:  "refill is capped at burst",
+			limit: RateLimit{Requests: 1, Period: time.Second, Burst: 2},
+			steps: []step{
+				{key: "a", ok: true},
+				{key: "a", ok: true},
+				{key: "a", after: time.Hour, ok: true},
+				{key: "a", ok: true},
+				{key: "a", ok: false, wait: time.Second},
+			},
+		},
+		{
+			name:  "keys are counted separately",
+			limit: RateLimit{Requests: 1, Period: time.Second},
+			steps: []step{
+				{key: "a", ok: true},
+				{key: "a", ok: false, wait: time.Second},
+				{key: "b", ok: true},
+				{key: "b", ok: false, wait: time.Second},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newRateLimiter(tt.limit)
+			now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+			for i, s := range tt.steps {
+				now = now.Add(s.after)
+
+				ok, wait := limiter.allow(s.key, now)
+				if ok != s.ok {
+					t.Fatalf("step %d: allow(%q) = %t, want %t", i, s.key, ok, s.ok)
+				}
+				if diff := wait - s.wait; diff < -time.Millisecond || diff > time.Millisecond {
+					t.Fatalf("step %d: wait = %s, want %s", i, wait, s.wait)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package middlewares
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/netbill/logium"
+)
+
+const (
+	defaultActivityFlushInterval = 30 * time.Second
+	defaultActivityMaxPending    = 10_000
+	activityFlushTimeout         = 10 * time.Second
+)
+
+type sessionsToucher interface {
+	TouchSessions(ctx context.Context, lastUsed map[uuid.UUID]time.Time) error
+}
+
+// sessionActivity coalesces "session was used" marks in memory so
+// authenticated requests don't cost a database write each.
+type sessionActivity struct {
+	sessions   sessionsToucher
+	log        *logium.Logger
+	interval   time.Duration
+	maxPending int
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]time.Time
+	flushCh chan struct{}
+}
+
+func newSessionActivity(
+	log *logium.Logger,
+	sessions sessionsToucher,
+	interval time.Duration,
+	maxPending int,
+) *sessionActivity {
+	if interval <= 0 {
+		interval = defaultActivityFlushInterval
+	}
+	if maxPending <= 0 {
+		maxPending = defaultActivityMaxPending
+	}
+
+	return &sessionActivity{
+		sessions:   sessions,
+		log:        log,
+		interval:   interval,
+		maxPending: maxPending,
+		pending:    make(map[uuid.UUID]time.Time),
+		flushCh:    make(chan struct{}, 1),
+	}
+}
+
+func (a *sessionActivity) touch(sessionID uuid.UUID, at time.Time) {
+	a.mu.Lock()
+	if prev, ok := a.pending[sessionID]; !ok || at.After(prev) {
+		a.pending[sessionID] = at
+	}
+	full := len(a.pending) >= a.maxPending
+	a.mu.Unlock()
+
+	if full {
+		select {
+		case a.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (a *sessionActivity) run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			shCtx, cancel := context.WithTimeout(context.Background(), activityFlushTimeout)
+			a.flush(shCtx)
+			cancel()
+
+			return
+		case <-ticker.C:
+			a.flush(ctx)
+		case <-a.flushCh:
+			a.flush(ctx)
+		}
+	}
+}
+
+func (a *sessionActivity) flush(ctx context.Context) {
+	a.mu.Lock()
+	if len(a.pending) == 0 {
+		a.mu.Unlock()
+		return
+	}
+	batch := a.pending
+	a.pending = make(map[uuid.UUID]time.Time, len(batch))
+	a.mu.Unlock()
+
+	if err := a.sessions.TouchSessions(ctx, batch); err != nil {
+		a.log.WithError(err).Errorf("failed to flush last used for %d sessions", len(batch))
+
+		// put the batch back so the next tick retries it, newer marks win
+		a.mu.Lock()
+		for id, at := range batch {
+			if prev, ok := a.pending[id]; !ok || at.After(prev) {
+				a.pending[id] = at
+			}
+		}
+		a.mu.Unlock()
+	}
+}
@@ -0,0 +1,71 @@
+package requests
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/internal/core/modules/account"
+)
+
+const (
+	filterRole           = "role"
+	filterStatus         = "status"
+	filterUsernamePrefix = "username"
+	filterEmail          = "email"
+	filterCreatedAfter   = "created_after"
+	filterCreatedBefore  = "created_before"
+)
+
+func FilterAccounts(r *http.Request) (account.AccountsFilter, error) {
+	query := r.URL.Query()
+
+	var (
+		filter account.AccountsFilter
+		errs   = validation.Errors{}
+	)
+
+	if val := query.Get(filterRole); val != "" {
+		errs["query/"+filterRole] = systemRole(val)
+		filter.Role = &val
+	}
+	if val := query.Get(filterStatus); val != "" {
+		errs["query/"+filterStatus] = validation.Validate(val, validation.In(accountStatuses()...))
+		filter.Status = &val
+	}
+	if val := query.Get(filterUsernamePrefix); val != "" {
+		errs["query/"+filterUsernamePrefix] = validation.Validate(val, validation.Length(1, 32))
+		filter.UsernamePrefix = &val
+	}
+	if val := models.NormalizeEmail(query.Get(filterEmail)); val != "" {
+		errs["query/"+filterEmail] = validation.Validate(val, validation.Length(1, 255))
+		filter.Email = &val
+	}
+	if val := query.Get(filterCreatedAfter); val != "" {
+		t, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			errs["query/"+filterCreatedAfter] = fmt.Errorf("must be an RFC 3339 date-time")
+		}
+		filter.CreatedAfter = &t
+	}
+	if val := query.Get(filterCreatedBefore); val != "" {
+		t, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			errs["query/"+filterCreatedBefore] = fmt.Errorf("must be an RFC 3339 date-time")
+		}
+		filter.CreatedBefore = &t
+	}
+
+	return filter, errs.Filter()
+}
+
+func accountStatuses() []interface{} {
+	statuses := models.GetAllAccountStatuses()
+	out := make([]interface{}, 0, len(statuses))
+	for _, status := range statuses {
+		out = append(out, status)
+	}
+	return out
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/internal/core/models"
 	"github.com/netbill/auth-svc/resources"
 )
 
@@ -14,9 +15,14 @@ func LoginByEmail(r *http.Request) (req resources.LoginByEmail, err error) {
 		return
 	}
 
+	req.Data.Attributes.Email = models.NormalizeEmail(req.Data.Attributes.Email)
+
 	errs := validation.Errors{
 		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("login_by_email")),
 		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/device_name": validation.Validate(
+			req.Data.Attributes.DeviceName, validation.Length(0, maxDeviceNameLength),
+		),
 	}
 	return req, errs.Filter()
 }
@@ -17,6 +17,9 @@ func LoginByUsername(r *http.Request) (req resources.LoginByUsername, err error)
 	errs := validation.Errors{
 		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("login_by_username")),
 		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/device_name": validation.Validate(
+			req.Data.Attributes.DeviceName, validation.Length(0, maxDeviceNameLength),
+		),
 	}
 	return req, errs.Filter()
 }
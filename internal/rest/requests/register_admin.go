@@ -7,6 +7,7 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/netbill/auth-svc/internal/core/models"
 	"github.com/netbill/auth-svc/resources"
 	"github.com/netbill/restkit/tokens/roles"
 )
@@ -25,6 +26,8 @@ func RegistrationAdmin(r *http.Request) (req resources.RegistrationAdmin, err er
 		return
 	}
 
+	req.Data.Attributes.Email = models.NormalizeEmail(req.Data.Attributes.Email)
+
 	errs := validation.Errors{
 		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("registration_account_by_admin")),
 		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
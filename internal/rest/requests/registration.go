@@ -6,6 +6,7 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/netbill/auth-svc/internal/core/models"
 	"github.com/netbill/auth-svc/resources"
 )
 
@@ -15,6 +16,8 @@ func Registration(r *http.Request) (req resources.Registration, err error) {
 		return
 	}
 
+	req.Data.Attributes.Email = models.NormalizeEmail(req.Data.Attributes.Email)
+
 	errs := validation.Errors{
 		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("registration_account")),
 		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/resources"
+)
+
+func SwitchActiveOrganization(r *http.Request) (req resources.SwitchActiveOrganization, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("switch_active_organization")),
+		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+	}
+
+	return req, errs.Filter()
+}
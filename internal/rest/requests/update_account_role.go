@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/resources"
+	"github.com/netbill/restkit/tokens/roles"
+)
+
+func UpdateAccountRole(r *http.Request) (req resources.UpdateAccountRole, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":         validation.Validate(req.Data.Id, validation.Required),
+		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("update_account_role")),
+		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/role": validation.Validate(
+			req.Data.Attributes.Role, validation.Required, validation.By(systemRole),
+		),
+	}
+
+	if chi.URLParam(r, "account_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query account_id and body data/id mismatch")
+	}
+
+	return req, errs.Filter()
+}
+
+func systemRole(value interface{}) error {
+	role, _ := value.(string)
+	return roles.ValidateUserSystemRole(role)
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/resources"
+)
+
+const maxDeviceNameLength = 64
+
+func UpdateAccountSession(r *http.Request) (req resources.UpdateAccountSession, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":         validation.Validate(req.Data.Id, validation.Required),
+		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("update_account_session")),
+		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/device_name": validation.Validate(
+			req.Data.Attributes.DeviceName, validation.Length(0, maxDeviceNameLength),
+		),
+	}
+
+	if chi.URLParam(r, "session_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query session_id and body data/id mismatch")
+	}
+
+	return req, errs.Filter()
+}
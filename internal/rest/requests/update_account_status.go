@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/resources"
+)
+
+const maxStatusReasonLength = 512
+
+func UpdateAccountStatus(r *http.Request) (req resources.UpdateAccountStatus, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":         validation.Validate(req.Data.Id, validation.Required),
+		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In("update_account_status")),
+		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/status": validation.Validate(
+			req.Data.Attributes.Status, validation.Required, validation.In(
+				models.AccountStatusActive, models.AccountStatusSuspended, models.AccountStatusBanned,
+			),
+		),
+		"data/attributes/reason": validation.Validate(
+			req.Data.Attributes.Reason, validation.Length(0, maxStatusReasonLength),
+		),
+	}
+
+	if req.Data.Attributes.Status == models.AccountStatusActive && req.Data.Attributes.Until != nil {
+		errs["data/attributes/until"] = fmt.Errorf("must be empty for active status")
+	}
+
+	if chi.URLParam(r, "account_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query account_id and body data/id mismatch")
+	}
+
+	return req, errs.Filter()
+}
@@ -1,8 +1,11 @@
 package responses
 
 import (
+	"net/http"
+
 	"github.com/netbill/auth-svc/internal/core/models"
 	"github.com/netbill/auth-svc/resources"
+	"github.com/netbill/restkit/pagi"
 )
 
 func Account(m models.Account) resources.Account {
@@ -11,13 +14,58 @@ func Account(m models.Account) resources.Account {
 			Id:   m.ID,
 			Type: "account",
 			Attributes: resources.AccountDataAttributes{
-				Role:      m.Role,
-				Username:  m.Username,
-				CreatedAt: m.CreatedAt,
-				UpdatedAt: m.UpdatedAt,
+				Role:         m.Role,
+				Username:     m.Username,
+				Status:       m.Status,
+				StatusReason: m.StatusReason,
+				StatusUntil:  m.StatusUntil,
+				CreatedAt:    m.CreatedAt,
+				UpdatedAt:    m.UpdatedAt,
 			},
 		},
 	}
 
 	return resp
 }
+
+func AccountsCollection(r *http.Request, page pagi.Page[[]models.Account]) resources.AccountsCollection {
+	data := make([]resources.AccountData, 0, len(page.Data))
+
+	for _, a := range page.Data {
+		data = append(data, Account(a).Data)
+	}
+
+	links := pagi.BuildPageLinks(r, page.Page, page.Size, page.Total)
+
+	return resources.AccountsCollection{
+		Data: data,
+		Links: resources.PaginationData{
+			First: links.First,
+			Last:  links.Last,
+			Prev:  links.Prev,
+			Next:  links.Next,
+			Self:  links.Self,
+		},
+	}
+}
+
+func AccountDetails(m models.AccountDetails) resources.AccountDetails {
+	return resources.AccountDetails{
+		Data: resources.AccountDetailsData{
+			Id:   m.Account.ID,
+			Type: "account_details",
+			Attributes: resources.AccountDetailsDataAttributes{
+				Role:          m.Account.Role,
+				Username:      m.Account.Username,
+				Status:        m.Account.Status,
+				StatusReason:  m.Account.StatusReason,
+				StatusUntil:   m.Account.StatusUntil,
+				Email:         m.Email.Email,
+				EmailVerified: m.Email.Verified,
+				SessionsCount: int64(m.SessionsCount),
+				CreatedAt:     m.Account.CreatedAt,
+				UpdatedAt:     m.Account.UpdatedAt,
+			},
+		},
+	}
+}
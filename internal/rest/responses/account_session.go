@@ -14,9 +14,15 @@ func AccountSession(m models.Session) resources.AccountSession {
 			Id:   m.ID,
 			Type: "account_session",
 			Attributes: resources.AccountSessionAttributes{
-				AccountId: m.AccountID,
-				CreatedAt: m.CreatedAt,
-				LastUsed:  m.LastUsed,
+				AccountId:            m.AccountID,
+				ActiveOrganizationId: m.ActiveOrganizationID,
+				DeviceName:           m.DeviceName,
+				Ip:                   optionalString(m.IP),
+				UserAgent:            optionalString(m.UserAgent),
+				Os:                   optionalString(m.OS),
+				Browser:              optionalString(m.Browser),
+				CreatedAt:            m.CreatedAt,
+				LastUsed:             m.LastUsed,
 			},
 		},
 	}
@@ -44,3 +50,11 @@ func AccountSessionsCollection(r *http.Request, page pagi.Page[[]models.Session]
 		},
 	}
 }
+
+func optionalString(val string) *string {
+	if val == "" {
+		return nil
+	}
+
+	return &val
+}
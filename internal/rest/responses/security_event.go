@@ -0,0 +1,45 @@
+package responses
+
+import (
+	"net/http"
+
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/resources"
+	"github.com/netbill/restkit/pagi"
+)
+
+func SecurityEvent(m models.SecurityEvent) resources.SecurityEventData {
+	return resources.SecurityEventData{
+		Id:   m.ID,
+		Type: "security_event",
+		Attributes: resources.SecurityEventDataAttributes{
+			Event:     m.Type,
+			Method:    optionalString(m.Method),
+			Reason:    optionalString(m.Reason),
+			Ip:        optionalString(m.IP),
+			UserAgent: optionalString(m.UserAgent),
+			CreatedAt: m.CreatedAt,
+		},
+	}
+}
+
+func SecurityEventsCollection(r *http.Request, page pagi.Page[[]models.SecurityEvent]) resources.SecurityEventsCollection {
+	data := make([]resources.SecurityEventData, 0, len(page.Data))
+
+	for _, e := range page.Data {
+		data = append(data, SecurityEvent(e))
+	}
+
+	links := pagi.BuildPageLinks(r, page.Page, page.Size, page.Total)
+
+	return resources.SecurityEventsCollection{
+		Data: data,
+		Links: resources.PaginationData{
+			First: links.First,
+			Last:  links.Last,
+			Prev:  links.Prev,
+			Next:  links.Next,
+			Self:  links.Self,
+		},
+	}
+}
@@ -11,11 +11,18 @@ func TokensPair(m models.TokensPair) resources.TokensPair {
 			Id:   m.SessionID,
 			Type: "tokens_pair",
 			Attributes: resources.TokensPairDataAttributes{
-				AccessToken:  m.Access,
-				RefreshToken: m.Refresh,
+				AccessToken:       m.Access,
+				EvictedSessionIds: m.EvictedSessionIDs,
 			},
 		},
 	}
 
+	if m.Refresh != "" {
+		resp.Data.Attributes.RefreshToken = &m.Refresh
+	}
+	if m.PasswordChangeRequired {
+		resp.Data.Attributes.PasswordChangeRequired = &m.PasswordChangeRequired
+	}
+
 	return resp
 }
@@ -0,0 +1,25 @@
+package responses
+
+import (
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/auth-svc/resources"
+)
+
+func UsernameLookup(m models.UsernameLookup) resources.UsernameLookup {
+	resp := resources.UsernameLookup{
+		Data: resources.UsernameLookupData{
+			Id:   m.Account.ID,
+			Type: "username_lookup",
+			Attributes: resources.UsernameLookupDataAttributes{
+				Username: m.Account.Username,
+			},
+		},
+	}
+
+	if m.Previous != nil {
+		resp.Data.Attributes.PreviousUsername = &m.Previous.Username
+		resp.Data.Attributes.ChangedAt = &m.Previous.ChangedAt
+	}
+
+	return resp
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
+	"github.com/netbill/auth-svc/internal/rest/middlewares"
 	"github.com/netbill/logium"
 	"github.com/netbill/restkit/tokens/roles"
 )
@@ -25,21 +26,40 @@ type Handlers interface {
 
 	RefreshSession(w http.ResponseWriter, r *http.Request)
 
+	LookupUsername(w http.ResponseWriter, r *http.Request)
+
 	GetMyAccount(w http.ResponseWriter, r *http.Request)
 	GetMySession(w http.ResponseWriter, r *http.Request)
 	GetMySessions(w http.ResponseWriter, r *http.Request)
 	GetMyEmailData(w http.ResponseWriter, r *http.Request)
+	GetMySecurityEvents(w http.ResponseWriter, r *http.Request)
+
+	UpdateMySession(w http.ResponseWriter, r *http.Request)
 
 	UpdatePassword(w http.ResponseWriter, r *http.Request)
 	UpdateUsername(w http.ResponseWriter, r *http.Request)
+	SwitchActiveOrganization(w http.ResponseWriter, r *http.Request)
 
 	DeleteMyAccount(w http.ResponseWriter, r *http.Request)
 	DeleteMySession(w http.ResponseWriter, r *http.Request)
 	DeleteMySessions(w http.ResponseWriter, r *http.Request)
+
+	AdminGetAccounts(w http.ResponseWriter, r *http.Request)
+	AdminGetAccount(w http.ResponseWriter, r *http.Request)
+	AdminUpdateAccountRole(w http.ResponseWriter, r *http.Request)
+	AdminUpdateAccountStatus(w http.ResponseWriter, r *http.Request)
+	AdminDeleteAccountSessions(w http.ResponseWriter, r *http.Request)
+	AdminDeleteAccountLockout(w http.ResponseWriter, r *http.Request)
+	AdminRequirePasswordChange(w http.ResponseWriter, r *http.Request)
+	AdminCancelPasswordChange(w http.ResponseWriter, r *http.Request)
+	AdminDeleteAccount(w http.ResponseWriter, r *http.Request)
 }
 
 type Middlewares interface {
+	ClientIP() func(http.Handler) http.Handler
+	RateLimit(group string, limit middlewares.RateLimit) func(http.Handler) http.Handler
 	AccountAuth() func(http.Handler) http.Handler
+	PasswordChangeAuth() func(http.Handler) http.Handler
 	AccountRoleGrant(allowedRoles map[string]bool) func(http.Handler) http.Handler
 }
 
@@ -67,14 +87,30 @@ type Config struct {
 	TimeoutReadHeader time.Duration
 	TimeoutWrite      time.Duration
 	TimeoutIdle       time.Duration
+
+	RateLimits RateLimits
+}
+
+// RateLimits configures limits of the route groups, a zero limit disables it.
+type RateLimits struct {
+	Registration middlewares.RateLimit
+	Login        middlewares.RateLimit
+	Google       middlewares.RateLimit
+	Refresh      middlewares.RateLimit
+	Me           middlewares.RateLimit
 }
 
 func (s *Service) Run(ctx context.Context, cfg Config) {
 	auth := s.middlewares.AccountAuth()
+	passwordChangeAuth := s.middlewares.PasswordChangeAuth()
 	sysadmin := s.middlewares.AccountRoleGrant(map[string]bool{
 		roles.SystemAdmin: true,
 	})
 
+	limit := func(group string, l middlewares.RateLimit) func(http.Handler) http.Handler {
+		return s.middlewares.RateLimit(group, l)
+	}
+
 	r := chi.NewRouter()
 
 	// CORS for swagger UI documentation need to delete after configuring nginx
@@ -86,43 +122,78 @@ func (s *Service) Run(ctx context.Context, cfg Config) {
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
+	r.Use(s.middlewares.ClientIP())
 
 	r.Route("/auth-svc", func(r chi.Router) {
 		r.Route("/v1", func(r chi.Router) {
 
 			r.Route("/registration", func(r chi.Router) {
-				r.Post("/", s.handlers.Registration)
+				r.With(limit("registration", cfg.RateLimits.Registration)).Post("/", s.handlers.Registration)
 				r.With(auth, sysadmin).Post("/admin", s.handlers.RegistrationByAdmin)
 			})
 
 			r.Route("/login", func(r chi.Router) {
-				r.Post("/email", s.handlers.LoginByEmail)
-				r.Post("/username", s.handlers.LoginByUsername)
+				r.With(limit("login", cfg.RateLimits.Login)).Group(func(r chi.Router) {
+					r.Post("/email", s.handlers.LoginByEmail)
+					r.Post("/username", s.handlers.LoginByUsername)
+				})
 
-				r.Route("/google", func(r chi.Router) {
+				r.With(limit("google", cfg.RateLimits.Google)).Route("/google", func(r chi.Router) {
 					r.Post("/", s.handlers.LoginByGoogleOAuth)
 					r.Post("/callback", s.handlers.LoginByGoogleOAuthCallback)
 				})
 			})
 
-			r.Post("/refresh", s.handlers.RefreshSession)
+			r.With(limit("refresh", cfg.RateLimits.Refresh)).Post("/refresh", s.handlers.RefreshSession)
 
-			r.With(auth).Route("/me", func(r chi.Router) {
-				r.With(auth).Get("/", s.handlers.GetMyAccount)
-				r.With(auth).Delete("/", s.handlers.DeleteMyAccount)
+			meLimit := limit("me", cfg.RateLimits.Me)
 
-				r.With(auth).Get("/email", s.handlers.GetMyEmailData)
-				r.With(auth).Post("/logout", s.handlers.Logout)
-				r.With(auth).Post("/password", s.handlers.UpdatePassword)
-				r.With(auth).Post("/username", s.handlers.UpdateUsername)
+			// Resolves usernames given up in a rename too, for services
+			// that stored an old handle.
+			r.With(auth, meLimit).Get("/usernames/{username}", s.handlers.LookupUsername)
 
-				r.With(auth).Route("/sessions", func(r chi.Router) {
-					r.Get("/", s.handlers.GetMySessions)
-					r.Delete("/", s.handlers.DeleteMySessions)
+			r.Route("/me", func(r chi.Router) {
+				// Restricted tokens of accounts that have to change the
+				// password are good for this route only.
+				r.With(passwordChangeAuth, meLimit).Post("/password", s.handlers.UpdatePassword)
+
+				r.With(auth, meLimit).Group(func(r chi.Router) {
+					r.Get("/", s.handlers.GetMyAccount)
+					r.Delete("/", s.handlers.DeleteMyAccount)
+
+					r.Get("/email", s.handlers.GetMyEmailData)
+					r.Post("/logout", s.handlers.Logout)
+					r.Post("/username", s.handlers.UpdateUsername)
+					r.Post("/organization", s.handlers.SwitchActiveOrganization)
+
+					r.Get("/security/events", s.handlers.GetMySecurityEvents)
+
+					r.Route("/sessions", func(r chi.Router) {
+						r.Get("/", s.handlers.GetMySessions)
+						r.Delete("/", s.handlers.DeleteMySessions)
+
+						r.Route("/{session_id}", func(r chi.Router) {
+							r.Get("/", s.handlers.GetMySession)
+							r.Patch("/", s.handlers.UpdateMySession)
+							r.Delete("/", s.handlers.DeleteMySession)
+						})
+					})
+				})
+			})
 
-					r.Route("/{session_id}", func(r chi.Router) {
-						r.Get("/", s.handlers.GetMySession)
-						r.Delete("/", s.handlers.DeleteMySession)
+			r.With(auth, sysadmin).Route("/admin", func(r chi.Router) {
+				r.Route("/accounts", func(r chi.Router) {
+					r.Get("/", s.handlers.AdminGetAccounts)
+
+					r.Route("/{account_id}", func(r chi.Router) {
+						r.Get("/", s.handlers.AdminGetAccount)
+						r.Delete("/", s.handlers.AdminDeleteAccount)
+						r.Patch("/role", s.handlers.AdminUpdateAccountRole)
+						r.Patch("/status", s.handlers.AdminUpdateAccountStatus)
+						r.Delete("/sessions", s.handlers.AdminDeleteAccountSessions)
+						r.Delete("/lockout", s.handlers.AdminDeleteAccountLockout)
+						r.Post("/password-change", s.handlers.AdminRequirePasswordChange)
+						r.Delete("/password-change", s.handlers.AdminCancelPasswordChange)
 					})
 				})
 			})
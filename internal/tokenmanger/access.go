@@ -3,12 +3,23 @@ package tokenmanger
 import (
 	"fmt"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/netbill/auth-svc/internal/core/models"
 	"github.com/netbill/restkit/tokens"
 )
 
-func (s Service) GenerateAccess(account models.Account, sessionID uuid.UUID) (string, error) {
+const (
+	orgsClaim      = "orgs"
+	activeOrgClaim = "active_org"
+)
+
+type orgClaim struct {
+	ID       uuid.UUID `json:"id"`
+	Position *string   `json:"position,omitempty"`
+}
+
+func (s Service) GenerateAccess(account models.Account, sessionID uuid.UUID, orgs models.AccessOrgs) (string, error) {
 	tkn, err := tokens.GenerateAccountJWT(tokens.GenerateAccountJwtRequest{
 		Issuer:    s.iss,
 		Audience:  []string{s.iss},
@@ -21,6 +32,31 @@ func (s Service) GenerateAccess(account models.Account, sessionID uuid.UUID) (st
 		return "", fmt.Errorf("failed to generate access token, cause: %w", err)
 	}
 
+	extra := make(map[string]any, 2)
+
+	// The claim is omitted instead of truncated, so consumers never
+	// mistake a partial list for the full set of memberships.
+	if len(orgs.Memberships) > 0 && len(orgs.Memberships) <= s.orgsClaimLimit {
+		claim := make([]orgClaim, 0, len(orgs.Memberships))
+		for _, m := range orgs.Memberships {
+			claim = append(claim, orgClaim{ID: m.OrganizationID, Position: m.Position})
+		}
+		extra[orgsClaim] = claim
+	}
+
+	if orgs.ActiveOrganizationID != nil {
+		extra[activeOrgClaim] = orgs.ActiveOrganizationID.String()
+	}
+
+	if len(extra) == 0 {
+		return tkn, nil
+	}
+
+	tkn, err = withClaims(tkn, s.accessSK, extra)
+	if err != nil {
+		return "", fmt.Errorf("failed to add organization claims to access token, cause: %w", err)
+	}
+
 	return tkn, nil
 }
 
@@ -32,3 +68,19 @@ func (s Service) ParseAccessClaims(tokenStr string) (tokens.AccountJwtData, erro
 
 	return data, nil
 }
+
+// withClaims re-signs a token issued by restkit with additional claims,
+// keeping the original claims and signing method untouched.
+func withClaims(tokenStr, secret string, extra map[string]any) (string, error) {
+	claims := jwt.MapClaims{}
+	token, _, err := jwt.NewParser().ParseUnverified(tokenStr, claims)
+	if err != nil {
+		return "", err
+	}
+
+	for k, v := range extra {
+		claims[k] = v
+	}
+
+	return jwt.NewWithClaims(token.Method, claims).SignedString([]byte(secret))
+}
@@ -0,0 +1,32 @@
+package tokenmanger
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/netbill/auth-svc/internal/core/models"
+	"github.com/netbill/restkit/tokens"
+)
+
+// GeneratePasswordChange issues an access token signed with its own key, so
+// services checking regular access tokens reject it and only the password
+// change endpoint accepts it.
+func (s Service) GeneratePasswordChange(account models.Account, sessionID uuid.UUID) (string, error) {
+	if s.passwordChangeSK == "" {
+		return "", fmt.Errorf("password change token secret key is not configured")
+	}
+
+	tkn, err := tokens.GenerateAccountJWT(tokens.GenerateAccountJwtRequest{
+		Issuer:    s.iss,
+		Audience:  []string{s.iss},
+		AccountID: account.ID,
+		SessionID: sessionID,
+		Role:      account.Role,
+		Ttl:       s.passwordChangeTTL,
+	}, s.passwordChangeSK)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate password change token, cause: %w", err)
+	}
+
+	return tkn, nil
+}
@@ -10,23 +10,31 @@ import (
 )
 
 type Service struct {
-	accessSK  string
-	refreshSK string
-	refreshHK string
+	accessSK         string
+	refreshSK        string
+	refreshHK        string
+	passwordChangeSK string
 
-	accessTTL  time.Duration
-	refreshTTL time.Duration
+	accessTTL         time.Duration
+	refreshTTL        time.Duration
+	passwordChangeTTL time.Duration
+
+	orgsClaimLimit int
 
 	iss string
 }
 
 type Config struct {
-	AccessSK  string
-	RefreshSK string
-	RefreshHK string
+	AccessSK         string
+	RefreshSK        string
+	RefreshHK        string
+	PasswordChangeSK string
+
+	AccessTTL         time.Duration
+	RefreshTTL        time.Duration
+	PasswordChangeTTL time.Duration
 
-	AccessTTL  time.Duration
-	RefreshTTL time.Duration
+	OrgsClaimLimit int
 
 	Iss string
 }
@@ -39,6 +47,11 @@ func NewManager(cfg Config) Service {
 		accessTTL:  cfg.AccessTTL,
 		refreshTTL: cfg.RefreshTTL,
 		iss:        cfg.Iss,
+
+		passwordChangeSK:  cfg.PasswordChangeSK,
+		passwordChangeTTL: cfg.PasswordChangeTTL,
+
+		orgsClaimLimit: cfg.OrgsClaimLimit,
 	}
 }
 
@@ -26,6 +26,12 @@ type AccountDataAttributes struct {
 	Role string `json:"role"`
 	// The username of the account
 	Username string `json:"username"`
+	// The status of the account
+	Status string `json:"status"`
+	// Why the account got its current status
+	StatusReason *string `json:"status_reason,omitempty"`
+	// When a temporary status ends
+	StatusUntil *time.Time `json:"status_until,omitempty"`
 	// The date and time when the account was created
 	CreatedAt time.Time `json:"created_at"`
 	// The date and time when the account was last updated
@@ -38,10 +44,11 @@ type _AccountDataAttributes AccountDataAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewAccountDataAttributes(role string, username string, createdAt time.Time, updatedAt time.Time) *AccountDataAttributes {
+func NewAccountDataAttributes(role string, username string, status string, createdAt time.Time, updatedAt time.Time) *AccountDataAttributes {
 	this := AccountDataAttributes{}
 	this.Role = role
 	this.Username = username
+	this.Status = status
 	this.CreatedAt = createdAt
 	this.UpdatedAt = updatedAt
 	return &this
@@ -103,6 +110,94 @@ func (o *AccountDataAttributes) SetUsername(v string) {
 	o.Username = v
 }
 
+// GetStatus returns the Status field value
+func (o *AccountDataAttributes) GetStatus() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Status
+}
+
+// GetStatusOk returns a tuple with the Status field value
+// and a boolean to check if the value has been set.
+func (o *AccountDataAttributes) GetStatusOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Status, true
+}
+
+// SetStatus sets field value
+func (o *AccountDataAttributes) SetStatus(v string) {
+	o.Status = v
+}
+
+// GetStatusReason returns the StatusReason field value if set, zero value otherwise.
+func (o *AccountDataAttributes) GetStatusReason() string {
+	if o == nil || IsNil(o.StatusReason) {
+		var ret string
+		return ret
+	}
+	return *o.StatusReason
+}
+
+// GetStatusReasonOk returns a tuple with the StatusReason field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountDataAttributes) GetStatusReasonOk() (*string, bool) {
+	if o == nil || IsNil(o.StatusReason) {
+		return nil, false
+	}
+	return o.StatusReason, true
+}
+
+// HasStatusReason returns a boolean if a field has been set.
+func (o *AccountDataAttributes) HasStatusReason() bool {
+	if o != nil && !IsNil(o.StatusReason) {
+		return true
+	}
+
+	return false
+}
+
+// SetStatusReason gets a reference to the given string and assigns it to the StatusReason field.
+func (o *AccountDataAttributes) SetStatusReason(v string) {
+	o.StatusReason = &v
+}
+
+// GetStatusUntil returns the StatusUntil field value if set, zero value otherwise.
+func (o *AccountDataAttributes) GetStatusUntil() time.Time {
+	if o == nil || IsNil(o.StatusUntil) {
+		var ret time.Time
+		return ret
+	}
+	return *o.StatusUntil
+}
+
+// GetStatusUntilOk returns a tuple with the StatusUntil field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountDataAttributes) GetStatusUntilOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.StatusUntil) {
+		return nil, false
+	}
+	return o.StatusUntil, true
+}
+
+// HasStatusUntil returns a boolean if a field has been set.
+func (o *AccountDataAttributes) HasStatusUntil() bool {
+	if o != nil && !IsNil(o.StatusUntil) {
+		return true
+	}
+
+	return false
+}
+
+// SetStatusUntil gets a reference to the given time.Time and assigns it to the StatusUntil field.
+func (o *AccountDataAttributes) SetStatusUntil(v time.Time) {
+	o.StatusUntil = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *AccountDataAttributes) GetCreatedAt() time.Time {
 	if o == nil {
@@ -163,6 +258,13 @@ func (o AccountDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["role"] = o.Role
 	toSerialize["username"] = o.Username
+	toSerialize["status"] = o.Status
+	if !IsNil(o.StatusReason) {
+		toSerialize["status_reason"] = o.StatusReason
+	}
+	if !IsNil(o.StatusUntil) {
+		toSerialize["status_until"] = o.StatusUntil
+	}
 	toSerialize["created_at"] = o.CreatedAt
 	toSerialize["updated_at"] = o.UpdatedAt
 	return toSerialize, nil
@@ -175,6 +277,7 @@ func (o *AccountDataAttributes) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"role",
 		"username",
+		"status",
 		"created_at",
 		"updated_at",
 	}
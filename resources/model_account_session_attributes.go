@@ -25,6 +25,18 @@ var _ MappedNullable = &AccountSessionAttributes{}
 type AccountSessionAttributes struct {
 	// account id
 	AccountId uuid.UUID `json:"account_id"`
+	// organization the session is scoped to
+	ActiveOrganizationId *uuid.UUID `json:"active_organization_id,omitempty"`
+	// device name given by the client
+	DeviceName *string `json:"device_name,omitempty"`
+	// last known IP address of the client
+	Ip *string `json:"ip,omitempty"`
+	// user agent the session was created with
+	UserAgent *string `json:"user_agent,omitempty"`
+	// operating system parsed from the user agent
+	Os *string `json:"os,omitempty"`
+	// browser parsed from the user agent
+	Browser *string `json:"browser,omitempty"`
 	// session creation date
 	CreatedAt time.Time `json:"created_at"`
 	// last used date
@@ -77,6 +89,198 @@ func (o *AccountSessionAttributes) SetAccountId(v uuid.UUID) {
 	o.AccountId = v
 }
 
+// GetActiveOrganizationId returns the ActiveOrganizationId field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetActiveOrganizationId() uuid.UUID {
+	if o == nil || IsNil(o.ActiveOrganizationId) {
+		var ret uuid.UUID
+		return ret
+	}
+	return *o.ActiveOrganizationId
+}
+
+// GetActiveOrganizationIdOk returns a tuple with the ActiveOrganizationId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetActiveOrganizationIdOk() (*uuid.UUID, bool) {
+	if o == nil || IsNil(o.ActiveOrganizationId) {
+		return nil, false
+	}
+	return o.ActiveOrganizationId, true
+}
+
+// HasActiveOrganizationId returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasActiveOrganizationId() bool {
+	if o != nil && !IsNil(o.ActiveOrganizationId) {
+		return true
+	}
+
+	return false
+}
+
+// SetActiveOrganizationId gets a reference to the given uuid.UUID and assigns it to the ActiveOrganizationId field.
+func (o *AccountSessionAttributes) SetActiveOrganizationId(v uuid.UUID) {
+	o.ActiveOrganizationId = &v
+}
+
+// GetDeviceName returns the DeviceName field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetDeviceName() string {
+	if o == nil || IsNil(o.DeviceName) {
+		var ret string
+		return ret
+	}
+	return *o.DeviceName
+}
+
+// GetDeviceNameOk returns a tuple with the DeviceName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetDeviceNameOk() (*string, bool) {
+	if o == nil || IsNil(o.DeviceName) {
+		return nil, false
+	}
+	return o.DeviceName, true
+}
+
+// HasDeviceName returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasDeviceName() bool {
+	if o != nil && !IsNil(o.DeviceName) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeviceName gets a reference to the given string and assigns it to the DeviceName field.
+func (o *AccountSessionAttributes) SetDeviceName(v string) {
+	o.DeviceName = &v
+}
+
+// GetIp returns the Ip field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetIp() string {
+	if o == nil || IsNil(o.Ip) {
+		var ret string
+		return ret
+	}
+	return *o.Ip
+}
+
+// GetIpOk returns a tuple with the Ip field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetIpOk() (*string, bool) {
+	if o == nil || IsNil(o.Ip) {
+		return nil, false
+	}
+	return o.Ip, true
+}
+
+// HasIp returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasIp() bool {
+	if o != nil && !IsNil(o.Ip) {
+		return true
+	}
+
+	return false
+}
+
+// SetIp gets a reference to the given string and assigns it to the Ip field.
+func (o *AccountSessionAttributes) SetIp(v string) {
+	o.Ip = &v
+}
+
+// GetUserAgent returns the UserAgent field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetUserAgent() string {
+	if o == nil || IsNil(o.UserAgent) {
+		var ret string
+		return ret
+	}
+	return *o.UserAgent
+}
+
+// GetUserAgentOk returns a tuple with the UserAgent field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetUserAgentOk() (*string, bool) {
+	if o == nil || IsNil(o.UserAgent) {
+		return nil, false
+	}
+	return o.UserAgent, true
+}
+
+// HasUserAgent returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasUserAgent() bool {
+	if o != nil && !IsNil(o.UserAgent) {
+		return true
+	}
+
+	return false
+}
+
+// SetUserAgent gets a reference to the given string and assigns it to the UserAgent field.
+func (o *AccountSessionAttributes) SetUserAgent(v string) {
+	o.UserAgent = &v
+}
+
+// GetOs returns the Os field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetOs() string {
+	if o == nil || IsNil(o.Os) {
+		var ret string
+		return ret
+	}
+	return *o.Os
+}
+
+// GetOsOk returns a tuple with the Os field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetOsOk() (*string, bool) {
+	if o == nil || IsNil(o.Os) {
+		return nil, false
+	}
+	return o.Os, true
+}
+
+// HasOs returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasOs() bool {
+	if o != nil && !IsNil(o.Os) {
+		return true
+	}
+
+	return false
+}
+
+// SetOs gets a reference to the given string and assigns it to the Os field.
+func (o *AccountSessionAttributes) SetOs(v string) {
+	o.Os = &v
+}
+
+// GetBrowser returns the Browser field value if set, zero value otherwise.
+func (o *AccountSessionAttributes) GetBrowser() string {
+	if o == nil || IsNil(o.Browser) {
+		var ret string
+		return ret
+	}
+	return *o.Browser
+}
+
+// GetBrowserOk returns a tuple with the Browser field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AccountSessionAttributes) GetBrowserOk() (*string, bool) {
+	if o == nil || IsNil(o.Browser) {
+		return nil, false
+	}
+	return o.Browser, true
+}
+
+// HasBrowser returns a boolean if a field has been set.
+func (o *AccountSessionAttributes) HasBrowser() bool {
+	if o != nil && !IsNil(o.Browser) {
+		return true
+	}
+
+	return false
+}
+
+// SetBrowser gets a reference to the given string and assigns it to the Browser field.
+func (o *AccountSessionAttributes) SetBrowser(v string) {
+	o.Browser = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *AccountSessionAttributes) GetCreatedAt() time.Time {
 	if o == nil {
@@ -136,6 +340,24 @@ func (o AccountSessionAttributes) MarshalJSON() ([]byte, error) {
 func (o AccountSessionAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["account_id"] = o.AccountId
+	if !IsNil(o.ActiveOrganizationId) {
+		toSerialize["active_organization_id"] = o.ActiveOrganizationId
+	}
+	if !IsNil(o.DeviceName) {
+		toSerialize["device_name"] = o.DeviceName
+	}
+	if !IsNil(o.Ip) {
+		toSerialize["ip"] = o.Ip
+	}
+	if !IsNil(o.UserAgent) {
+		toSerialize["user_agent"] = o.UserAgent
+	}
+	if !IsNil(o.Os) {
+		toSerialize["os"] = o.Os
+	}
+	if !IsNil(o.Browser) {
+		toSerialize["browser"] = o.Browser
+	}
 	toSerialize["created_at"] = o.CreatedAt
 	toSerialize["last_used"] = o.LastUsed
 	return toSerialize, nil
@@ -25,6 +25,8 @@ type LoginByEmailDataAttributes struct {
 	Email string `json:"email"`
 	// The account's password.
 	Password string `json:"password"`
+	// Optional human-readable name of the device the session is created on.
+	DeviceName *string `json:"device_name,omitempty"`
 }
 
 type _LoginByEmailDataAttributes LoginByEmailDataAttributes
@@ -96,6 +98,38 @@ func (o *LoginByEmailDataAttributes) SetPassword(v string) {
 	o.Password = v
 }
 
+// GetDeviceName returns the DeviceName field value if set, zero value otherwise.
+func (o *LoginByEmailDataAttributes) GetDeviceName() string {
+	if o == nil || IsNil(o.DeviceName) {
+		var ret string
+		return ret
+	}
+	return *o.DeviceName
+}
+
+// GetDeviceNameOk returns a tuple with the DeviceName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *LoginByEmailDataAttributes) GetDeviceNameOk() (*string, bool) {
+	if o == nil || IsNil(o.DeviceName) {
+		return nil, false
+	}
+	return o.DeviceName, true
+}
+
+// HasDeviceName returns a boolean if a field has been set.
+func (o *LoginByEmailDataAttributes) HasDeviceName() bool {
+	if o != nil && !IsNil(o.DeviceName) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeviceName gets a reference to the given string and assigns it to the DeviceName field.
+func (o *LoginByEmailDataAttributes) SetDeviceName(v string) {
+	o.DeviceName = &v
+}
+
 func (o LoginByEmailDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -108,6 +142,9 @@ func (o LoginByEmailDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["email"] = o.Email
 	toSerialize["password"] = o.Password
+	if !IsNil(o.DeviceName) {
+		toSerialize["device_name"] = o.DeviceName
+	}
 	return toSerialize, nil
 }
 
@@ -25,6 +25,8 @@ type LoginByUsernameDataAttributes struct {
 	Username string `json:"username"`
 	// The account's password.
 	Password string `json:"password"`
+	// Optional human-readable name of the device the session is created on.
+	DeviceName *string `json:"device_name,omitempty"`
 }
 
 type _LoginByUsernameDataAttributes LoginByUsernameDataAttributes
@@ -96,6 +98,38 @@ func (o *LoginByUsernameDataAttributes) SetPassword(v string) {
 	o.Password = v
 }
 
+// GetDeviceName returns the DeviceName field value if set, zero value otherwise.
+func (o *LoginByUsernameDataAttributes) GetDeviceName() string {
+	if o == nil || IsNil(o.DeviceName) {
+		var ret string
+		return ret
+	}
+	return *o.DeviceName
+}
+
+// GetDeviceNameOk returns a tuple with the DeviceName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *LoginByUsernameDataAttributes) GetDeviceNameOk() (*string, bool) {
+	if o == nil || IsNil(o.DeviceName) {
+		return nil, false
+	}
+	return o.DeviceName, true
+}
+
+// HasDeviceName returns a boolean if a field has been set.
+func (o *LoginByUsernameDataAttributes) HasDeviceName() bool {
+	if o != nil && !IsNil(o.DeviceName) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeviceName gets a reference to the given string and assigns it to the DeviceName field.
+func (o *LoginByUsernameDataAttributes) SetDeviceName(v string) {
+	o.DeviceName = &v
+}
+
 func (o LoginByUsernameDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -108,6 +142,9 @@ func (o LoginByUsernameDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["username"] = o.Username
 	toSerialize["password"] = o.Password
+	if !IsNil(o.DeviceName) {
+		toSerialize["device_name"] = o.DeviceName
+	}
 	return toSerialize, nil
 }
 
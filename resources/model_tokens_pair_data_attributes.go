@@ -12,6 +12,7 @@ package resources
 
 import (
 	"encoding/json"
+	"github.com/google/uuid"
 	"bytes"
 	"fmt"
 )
@@ -21,10 +22,14 @@ var _ MappedNullable = &TokensPairDataAttributes{}
 
 // TokensPairDataAttributes struct for TokensPairDataAttributes
 type TokensPairDataAttributes struct {
-	// Access Token
+	// Access Token, restricted to changing the password when password_change_required is set
 	AccessToken string `json:"access_token"`
-	// Refresh Token
-	RefreshToken string `json:"refresh_token"`
+	// Refresh Token, not issued while a password change is required
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	// Sessions closed to stay within the account's concurrent sessions limit
+	EvictedSessionIds []uuid.UUID `json:"evicted_session_ids,omitempty"`
+	// The password has to be changed before the account can be used
+	PasswordChangeRequired *bool `json:"password_change_required,omitempty"`
 }
 
 type _TokensPairDataAttributes TokensPairDataAttributes
@@ -33,10 +38,9 @@ type _TokensPairDataAttributes TokensPairDataAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewTokensPairDataAttributes(accessToken string, refreshToken string) *TokensPairDataAttributes {
+func NewTokensPairDataAttributes(accessToken string) *TokensPairDataAttributes {
 	this := TokensPairDataAttributes{}
 	this.AccessToken = accessToken
-	this.RefreshToken = refreshToken
 	return &this
 }
 
@@ -72,28 +76,100 @@ func (o *TokensPairDataAttributes) SetAccessToken(v string) {
 	o.AccessToken = v
 }
 
-// GetRefreshToken returns the RefreshToken field value
+// GetRefreshToken returns the RefreshToken field value if set, zero value otherwise.
 func (o *TokensPairDataAttributes) GetRefreshToken() string {
-	if o == nil {
+	if o == nil || IsNil(o.RefreshToken) {
 		var ret string
 		return ret
 	}
-
-	return o.RefreshToken
+	return *o.RefreshToken
 }
 
-// GetRefreshTokenOk returns a tuple with the RefreshToken field value
+// GetRefreshTokenOk returns a tuple with the RefreshToken field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *TokensPairDataAttributes) GetRefreshTokenOk() (*string, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.RefreshToken) {
 		return nil, false
 	}
-	return &o.RefreshToken, true
+	return o.RefreshToken, true
+}
+
+// HasRefreshToken returns a boolean if a field has been set.
+func (o *TokensPairDataAttributes) HasRefreshToken() bool {
+	if o != nil && !IsNil(o.RefreshToken) {
+		return true
+	}
+
+	return false
 }
 
-// SetRefreshToken sets field value
+// SetRefreshToken gets a reference to the given string and assigns it to the RefreshToken field.
 func (o *TokensPairDataAttributes) SetRefreshToken(v string) {
-	o.RefreshToken = v
+	o.RefreshToken = &v
+}
+
+// GetEvictedSessionIds returns the EvictedSessionIds field value if set, zero value otherwise.
+func (o *TokensPairDataAttributes) GetEvictedSessionIds() []uuid.UUID {
+	if o == nil || IsNil(o.EvictedSessionIds) {
+		var ret []uuid.UUID
+		return ret
+	}
+	return o.EvictedSessionIds
+}
+
+// GetEvictedSessionIdsOk returns a tuple with the EvictedSessionIds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TokensPairDataAttributes) GetEvictedSessionIdsOk() ([]uuid.UUID, bool) {
+	if o == nil || IsNil(o.EvictedSessionIds) {
+		return nil, false
+	}
+	return o.EvictedSessionIds, true
+}
+
+// HasEvictedSessionIds returns a boolean if a field has been set.
+func (o *TokensPairDataAttributes) HasEvictedSessionIds() bool {
+	if o != nil && !IsNil(o.EvictedSessionIds) {
+		return true
+	}
+
+	return false
+}
+
+// SetEvictedSessionIds gets a reference to the given []uuid.UUID and assigns it to the EvictedSessionIds field.
+func (o *TokensPairDataAttributes) SetEvictedSessionIds(v []uuid.UUID) {
+	o.EvictedSessionIds = v
+}
+
+// GetPasswordChangeRequired returns the PasswordChangeRequired field value if set, zero value otherwise.
+func (o *TokensPairDataAttributes) GetPasswordChangeRequired() bool {
+	if o == nil || IsNil(o.PasswordChangeRequired) {
+		var ret bool
+		return ret
+	}
+	return *o.PasswordChangeRequired
+}
+
+// GetPasswordChangeRequiredOk returns a tuple with the PasswordChangeRequired field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TokensPairDataAttributes) GetPasswordChangeRequiredOk() (*bool, bool) {
+	if o == nil || IsNil(o.PasswordChangeRequired) {
+		return nil, false
+	}
+	return o.PasswordChangeRequired, true
+}
+
+// HasPasswordChangeRequired returns a boolean if a field has been set.
+func (o *TokensPairDataAttributes) HasPasswordChangeRequired() bool {
+	if o != nil && !IsNil(o.PasswordChangeRequired) {
+		return true
+	}
+
+	return false
+}
+
+// SetPasswordChangeRequired gets a reference to the given bool and assigns it to the PasswordChangeRequired field.
+func (o *TokensPairDataAttributes) SetPasswordChangeRequired(v bool) {
+	o.PasswordChangeRequired = &v
 }
 
 func (o TokensPairDataAttributes) MarshalJSON() ([]byte, error) {
@@ -107,7 +183,15 @@ func (o TokensPairDataAttributes) MarshalJSON() ([]byte, error) {
 func (o TokensPairDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["access_token"] = o.AccessToken
-	toSerialize["refresh_token"] = o.RefreshToken
+	if !IsNil(o.RefreshToken) {
+		toSerialize["refresh_token"] = o.RefreshToken
+	}
+	if !IsNil(o.EvictedSessionIds) {
+		toSerialize["evicted_session_ids"] = o.EvictedSessionIds
+	}
+	if !IsNil(o.PasswordChangeRequired) {
+		toSerialize["password_change_required"] = o.PasswordChangeRequired
+	}
 	return toSerialize, nil
 }
 
@@ -117,7 +201,6 @@ func (o *TokensPairDataAttributes) UnmarshalJSON(data []byte) (err error) {
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
 		"access_token",
-		"refresh_token",
 	}
 
 	allProperties := make(map[string]interface{})